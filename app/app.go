@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -32,6 +33,9 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
@@ -47,6 +51,7 @@ import (
 
 	"contactical/docs"
 	contacticalmodulekeeper "contactical/x/contactical/keeper"
+	realityante "contactical/x/reality/ante"
 	realitymodulekeeper "contactical/x/reality/keeper"
 )
 
@@ -92,6 +97,8 @@ type App struct {
 	ConsensusParamsKeeper consensuskeeper.Keeper
 	CircuitBreakerKeeper  circuitkeeper.Keeper
 	ParamsKeeper          paramskeeper.Keeper
+	EpochsKeeper          epochskeeper.Keeper
+	NFTKeeper             nftkeeper.Keeper
 
 	// ibc keepers
 	IBCKeeper           *ibckeeper.Keeper
@@ -118,6 +125,11 @@ func init() {
 func AppConfig() depinject.Config {
 	return depinject.Configs(
 		appConfig,
+		// modules dispatching messages get the router rejecting device identity NFT transfers
+		depinject.BindInterface(
+			"github.com/cosmos/cosmos-sdk/baseapp/baseapp.MessageRouter",
+			"contactical/x/reality/ante/*ante.SoulboundRouter",
+		),
 		depinject.Supply(
 			// supply custom module basics
 			map[string]module.AppModuleBasic{
@@ -162,10 +174,14 @@ func New(
 		)
 	)
 
-	var appModules map[string]appmodule.AppModule
+	var (
+		appModules map[string]appmodule.AppModule
+		epochHooks map[string]epochstypes.EpochHooksWrapper
+	)
 	if err := depinject.Inject(appConfig,
 		&appBuilder,
 		&appModules,
+		&epochHooks,
 		&app.appCodec,
 		&app.legacyAmino,
 		&app.txConfig,
@@ -182,6 +198,8 @@ func New(
 		&app.ConsensusParamsKeeper,
 		&app.CircuitBreakerKeeper,
 		&app.ParamsKeeper,
+		&app.EpochsKeeper,
+		&app.NFTKeeper,
 		&app.ContacticalKeeper,
 		&app.RealityKeeper,
 	); err != nil {
@@ -200,6 +218,18 @@ func New(
 		panic(err)
 	}
 
+	// x/epochs keeps its own copy of the keeper inside the AppModule and its depinject
+	// hook invoker expects a keeper pointer that no module provides, so set the epoch
+	// hooks provided by the modules here and swap in a module built from the hooked keeper.
+	if err := epochs.InvokeSetHooks(&app.EpochsKeeper, epochHooks); err != nil {
+		panic(err)
+	}
+	app.ModuleManager.Modules[epochstypes.ModuleName] = epochs.NewAppModule(app.EpochsKeeper)
+
+	// device identity NFTs are soulbound: reject x/nft transfers of the reality device class
+	// (messages dispatched by modules are checked by the realityante.SoulboundRouter)
+	app.SetAnteHandler(realityante.WrapAnteHandler(app.AnteHandler()))
+
 	/****  Module Options ****/
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
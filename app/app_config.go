@@ -85,7 +85,10 @@ var (
 		{Account: nft.ModuleName},
 		{Account: ibctransfertypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: icatypes.ModuleName},
-		{Account: realitymoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking}}}
+		{Account: realitymoduletypes.ModuleName},
+		{Account: realitymoduletypes.RewardsAccountName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
+		{Account: realitymoduletypes.PoolAccountName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
+		{Account: realitymoduletypes.EscrowAccountName, Permissions: []string{authtypes.Burner}}}
 
 	// blocked account addresses
 	blockAccAddrs = []string{
@@ -95,6 +98,10 @@ var (
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
 		nft.ModuleName,
+		realitymoduletypes.ModuleName,
+		realitymoduletypes.RewardsAccountName,
+		realitymoduletypes.PoolAccountName,
+		realitymoduletypes.EscrowAccountName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 	}
@@ -0,0 +1,146 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/stretchr/testify/require"
+
+	realitytypes "contactical/x/reality/types"
+)
+
+// setupApp returns an app initialized from the default genesis with a single
+// validator and a funded account, after its first block. The first block
+// starts the epoch counting at genesisTime.
+func setupApp(t *testing.T, genesisTime time.Time) (*App, sdk.AccAddress) {
+	t.Helper()
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), baseapp.SetChainID(SimAppChainID))
+
+	pubKey, err := mock.NewPV().GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	acc := authtypes.NewBaseAccount(addr, nil, 0, 0)
+	balance := banktypes.Balance{
+		Address: addr.String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100_000_000_000))),
+	}
+	genesis, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+	stateBytes, err := json.Marshal(genesis)
+	require.NoError(t, err)
+
+	_, err = app.InitChain(&abci.RequestInitChain{
+		ChainId:         SimAppChainID,
+		Time:            genesisTime,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+	finalizeBlock(t, app, genesisTime)
+	return app, addr
+}
+
+// finalizeBlock finalizes and commits the next block at blockTime.
+func finalizeBlock(t *testing.T, app *App, blockTime time.Time) {
+	t.Helper()
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: app.LastBlockHeight() + 1, Time: blockTime})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+}
+
+// newContext returns a context writing straight to the committed state.
+func newContext(app *App, blockTime time.Time) sdk.Context {
+	return app.NewUncachedContext(false, cmtproto.Header{ChainID: SimAppChainID, Height: app.LastBlockHeight(), Time: blockTime})
+}
+
+func TestEpochEndRunsRealityHooks(t *testing.T) {
+	genesisTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	app, addr := setupApp(t, genesisTime)
+
+	ctx := newContext(app, genesisTime)
+	params, err := app.RealityKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	node := addr.String()
+	require.NoError(t, app.RealityKeeper.NodeInfo.Set(ctx, node, realitytypes.NodeInfo{Creator: node, Reputation: 42}))
+
+	// epoch 1이 끝나기 전에는 체크포인트가 없음
+	finalizeBlock(t, app, genesisTime.Add(time.Hour))
+	has, err := app.RealityKeeper.ReputationHistory.Has(newContext(app, genesisTime), collections.Join(node, int64(1)))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// 보상 epoch("day")가 끝나면 epochs 모듈의 훅이 평판 체크포인트를 기록
+	require.Equal(t, "day", params.EpochIdentifier)
+	finalizeBlock(t, app, genesisTime.Add(25*time.Hour))
+	checkpoint, err := app.RealityKeeper.ReputationHistory.Get(newContext(app, genesisTime), collections.Join(node, int64(1)))
+	require.NoError(t, err)
+	require.Equal(t, int64(42), checkpoint.Reputation)
+}
+
+// deliverMsg runs msg through the message router, as the message of a
+// transaction would be.
+func deliverMsg(t *testing.T, app *App, ctx sdk.Context, msg sdk.Msg) *sdk.Result {
+	t.Helper()
+	handler := app.MsgServiceRouter().Handler(msg)
+	require.NotNil(t, handler)
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+	return res
+}
+
+func TestGroupProposalCannotTransferDeviceNFT(t *testing.T) {
+	genesisTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	app, admin := setupApp(t, genesisTime)
+	ctx := newContext(app, genesisTime)
+
+	// 관리자 한 명이 임계값 1로 바로 실행하는 그룹 정책 계정
+	create := &group.MsgCreateGroupWithPolicy{
+		Admin:   admin.String(),
+		Members: []group.MemberRequest{{Address: admin.String(), Weight: "1"}},
+	}
+	require.NoError(t, create.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour, 0)))
+	res := deliverMsg(t, app, ctx, create)
+	policy := res.MsgResponses[0].GetCachedValue().(*group.MsgCreateGroupWithPolicyResponse).GroupPolicyAddress
+
+	// 그룹 정책 계정이 기기 NFT와 일반 NFT를 하나씩 보유
+	for _, classID := range []string{realitytypes.DeviceClassId, "artwork"} {
+		if !app.NFTKeeper.HasClass(ctx, classID) {
+			require.NoError(t, app.NFTKeeper.SaveClass(ctx, nft.Class{Id: classID}))
+		}
+		require.NoError(t, app.NFTKeeper.Mint(ctx, nft.NFT{ClassId: classID, Id: "token-1"}, sdk.MustAccAddressFromBech32(policy)))
+	}
+
+	receiver := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	for _, classID := range []string{realitytypes.DeviceClassId, "artwork"} {
+		propose := &group.MsgSubmitProposal{
+			GroupPolicyAddress: policy,
+			Proposers:          []string{admin.String()},
+			Exec:               group.Exec_EXEC_TRY,
+		}
+		require.NoError(t, propose.SetMsgs([]sdk.Msg{&nft.MsgSend{ClassId: classID, Id: "token-1", Sender: policy, Receiver: receiver.String()}}))
+		deliverMsg(t, app, ctx, propose)
+	}
+
+	// 제안 실행은 앤테 핸들러를 거치지 않지만 기기 NFT 전송은 메시지 라우터에서 거부됨
+	require.Equal(t, policy, app.NFTKeeper.GetOwner(ctx, realitytypes.DeviceClassId, "token-1").String())
+	require.Equal(t, receiver, app.NFTKeeper.GetOwner(ctx, "artwork", "token-1"))
+}
@@ -30,6 +30,8 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	realityante "contactical/x/reality/ante"
 )
 
 // registerIBCModules register IBC keepers and non dependency inject modules.
@@ -84,7 +86,7 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
 		app.IBCKeeper.ChannelKeeper,
 		app.AuthKeeper,
-		app.MsgServiceRouter(),
+		realityante.NewSoulboundRouter(app.MsgServiceRouter()), // interchain accounts cannot transfer device identity NFTs
 		app.GRPCQueryRouter(),
 		govModuleAddr,
 	)
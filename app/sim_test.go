@@ -30,6 +30,8 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+
+	realitymodulekeeper "contactical/x/reality/keeper"
 )
 
 const (
@@ -152,6 +154,11 @@ func TestFullAppSimulation(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, simErr)
 
+	// the x/reality invariants must hold on the simulated state
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	res, broken := realitymodulekeeper.AllInvariants(app.RealityKeeper)(ctx)
+	require.False(t, broken, res)
+
 	if config.Commit {
 		simtestutil.PrintStats(db)
 	}
@@ -0,0 +1,96 @@
+// Command contactical-indexer follows a Contactical chain, indexes the claims,
+// node registrations, reward payouts and swaps of its typed events into
+// SQLite or Postgres, and serves them over an HTTP API:
+//
+//	contactical-indexer --node tcp://localhost:26657 --db indexer.db --listen :8000
+//	contactical-indexer --db-driver postgres --db "postgres://indexer@localhost/contactical?sslmode=disable"
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/spf13/cobra"
+
+	"contactical/indexer"
+)
+
+const (
+	flagNode         = "node"
+	flagDBDriver     = "db-driver"
+	flagDB           = "db"
+	flagListen       = "listen"
+	flagPollInterval = "poll-interval"
+	flagStartHeight  = "start-height"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	cmd := rootCmd()
+	if err := cmd.ExecuteContext(ctx); err != nil {
+		fmt.Fprintln(cmd.OutOrStderr(), err)
+		os.Exit(1)
+	}
+}
+
+func rootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "contactical-indexer",
+		Short:        "Index claims and rewards into SQLite or Postgres and serve them as GeoJSON",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			node, _ := cmd.Flags().GetString(flagNode)
+			client, err := rpchttp.New(node, "/websocket")
+			if err != nil {
+				return err
+			}
+			driver, _ := cmd.Flags().GetString(flagDBDriver)
+			dsn, _ := cmd.Flags().GetString(flagDB)
+			store, err := indexer.OpenStore(cmd.Context(), driver, dsn)
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+
+			start, _ := cmd.Flags().GetInt64(flagStartHeight)
+			interval, _ := cmd.Flags().GetDuration(flagPollInterval)
+			follower := indexer.NewFollower(client, store, start, interval)
+			go follower.Run(cmd.Context(), func(err error) {
+				cmd.PrintErrf("indexing failed: %v\n", err)
+			})
+
+			addr, _ := cmd.Flags().GetString(flagListen)
+			listener, err := net.Listen("tcp", addr)
+			if err != nil {
+				return err
+			}
+			srv := &http.Server{Handler: indexer.NewAPI(store)}
+			go func() {
+				<-cmd.Context().Done()
+				_ = srv.Close()
+			}()
+			cmd.Printf("indexing %s into %s, serving on %s\n", node, driver, listener.Addr())
+			if err := srv.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagNode, "tcp://localhost:26657", "CometBFT RPC address of the chain")
+	cmd.Flags().String(flagDBDriver, indexer.DriverSQLite, "Database driver: sqlite or postgres")
+	cmd.Flags().String(flagDB, "indexer.db", "Database file (sqlite) or connection string (postgres)")
+	cmd.Flags().String(flagListen, ":8000", "Address the HTTP API listens on")
+	cmd.Flags().Duration(flagPollInterval, indexer.DefaultPollInterval, "How often new blocks are indexed")
+	cmd.Flags().Int64(flagStartHeight, 1, "Height indexing starts from when the database is empty")
+	return cmd
+}
@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"contactical/gateway"
+	apiv1 "contactical/proto/contactical/contactical/api/v1"
+)
+
+const (
+	flagListen       = "listen"
+	flagChallengeTTL = "challenge-ttl"
+	flagChallengeDB  = "challenge-db"
+	flagAttestRoots  = "attestation-roots"
+	flagAttestStatus = "attestation-status"
+	flagBatchMaxMsgs = "batch-max-msgs"
+	flagBatchMaxGas  = "batch-max-gas"
+	flagBatchFlush   = "batch-flush-interval"
+	flagRelayerKeys  = "relayer-keys"
+	flagStrategy     = "relayer-strategy"
+	flagTreasury     = "treasury"
+	flagTopUpMin     = "top-up-threshold"
+	flagTopUpAmount  = "top-up-amount"
+	flagTopUpEvery   = "top-up-interval"
+	flagMetrics      = "metrics-listen"
+)
+
+// GatewayCmd returns the command serving the ContacticalService device API.
+// Device requests are relayed as transactions signed by a pool of relayer
+// keys, the --from key by default.
+func GatewayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway",
+		Short: "Serve the ContacticalService device gateway relaying through a pool of relayer keys",
+		Example: fmt.Sprintf(`%sd gateway --from relayer --attestation-roots roots.pem --listen :9095 --node tcp://localhost:26657 --gas-prices 0.025stake
+%sd gateway --from treasury --attestation-roots roots.pem --attestation-status https://android.googleapis.com/attestation/status --relayer-keys relayer1,relayer2,relayer3 --relayer-strategy least-loaded --treasury treasury --top-up-threshold 1000000stake --top-up-amount 10000000stake`,
+			"contactical", "contactical"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			rootsFile, _ := cmd.Flags().GetString(flagAttestRoots)
+			statusList, _ := cmd.Flags().GetString(flagAttestStatus)
+			roots, err := gateway.LoadAttestationRoots(rootsFile, statusList)
+			if err != nil {
+				return err
+			}
+			pool, err := newRelayerPool(cmd, clientCtx, txf)
+			if err != nil {
+				return err
+			}
+			if err := pool.TopUp(cmd.Context()); err != nil {
+				return err
+			}
+			topUpEvery, _ := cmd.Flags().GetDuration(flagTopUpEvery)
+			go pool.Run(cmd.Context(), topUpEvery, func(err error) {
+				cmd.PrintErrf("relayer top-up failed: %v\n", err)
+			})
+
+			// 챌린지 저장소 경로가 없으면 메모리에 보관
+			store := gateway.NewMemChallengeStore()
+			if dir, _ := cmd.Flags().GetString(flagChallengeDB); dir != "" {
+				if store, err = gateway.NewLevelDBChallengeStore(dir); err != nil {
+					return err
+				}
+			}
+			ttl, _ := cmd.Flags().GetDuration(flagChallengeTTL)
+			challenges := gateway.NewChallenges(store, ttl)
+
+			batchCfg := gateway.DefaultBatchConfig()
+			batchCfg.MaxMsgs, _ = cmd.Flags().GetInt(flagBatchMaxMsgs)
+			batchCfg.MaxGas, _ = cmd.Flags().GetUint64(flagBatchMaxGas)
+			batchCfg.FlushInterval, _ = cmd.Flags().GetDuration(flagBatchFlush)
+			batcher := gateway.NewBatcher(pool, batchCfg)
+			go batcher.Run(cmd.Context())
+			watcher := gateway.NewClaimWatcher(clientCtx, batcher, clientCtx.NodeURI, gateway.DefaultReconcileInterval)
+			go func() {
+				if err := watcher.Run(cmd.Context()); err != nil {
+					cmd.PrintErrf("claim watcher stopped: %v\n", err)
+				}
+			}()
+
+			addr, _ := cmd.Flags().GetString(flagListen)
+			listener, err := net.Listen("tcp", addr)
+			if err != nil {
+				return err
+			}
+			srv := grpc.NewServer()
+			apiv1.RegisterContacticalServiceServer(srv, gateway.NewServer(clientCtx, pool, challenges, roots, batcher))
+
+			go func() {
+				<-cmd.Context().Done()
+				srv.GracefulStop()
+			}()
+			cmd.Printf("gateway listening on %s with %d relayer keys\n", listener.Addr(), pool.Size())
+			return srv.Serve(listener)
+		},
+	}
+
+	cmd.Flags().String(flagListen, ":9095", "Address the gateway gRPC server listens on")
+	cmd.Flags().Duration(flagChallengeTTL, gateway.DefaultChallengeTTL, "How long an issued registration challenge stays valid")
+	cmd.Flags().String(flagChallengeDB, "", "Directory of a LevelDB database keeping issued challenges (in memory if empty)")
+	cmd.Flags().String(flagAttestRoots, "", "PEM bundle of the hardware attestation roots device certificate chains must lead to")
+	cmd.Flags().String(flagAttestStatus, "", "File or URL of the attestation status list of revoked certificates (none revoked if empty)")
+	_ = cmd.MarkFlagRequired(flagAttestRoots)
+	defaultBatch := gateway.DefaultBatchConfig()
+	cmd.Flags().Int(flagBatchMaxMsgs, defaultBatch.MaxMsgs, "Maximum number of claims relayed in one transaction")
+	cmd.Flags().Uint64(flagBatchMaxGas, defaultBatch.MaxGas, "Gas budget of one batch transaction")
+	cmd.Flags().Duration(flagBatchFlush, defaultBatch.FlushInterval, "How long claims wait for a batch to fill before being relayed")
+	cmd.Flags().StringSlice(flagRelayerKeys, nil, "Keyring keys relaying transactions (the --from key if empty)")
+	cmd.Flags().String(flagStrategy, string(gateway.StrategyRoundRobin), "Relayer key selection: round-robin or least-loaded")
+	cmd.Flags().String(flagTreasury, "", "Keyring key topping the relayer keys up (no top-up if empty)")
+	cmd.Flags().String(flagTopUpMin, "", "Balance under which a relayer key is topped up, e.g. 1000000stake")
+	cmd.Flags().String(flagTopUpAmount, "", "Amount sent to a relayer key under the threshold, e.g. 10000000stake")
+	cmd.Flags().Duration(flagTopUpEvery, time.Minute, "How often relayer balances are checked")
+	cmd.Flags().String(flagMetrics, ":9096", "Address serving the Prometheus metrics of the relayer keys (disabled if empty)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// newRelayerPool returns the relayer pool configured by the flags of cmd and
+// serves its metrics.
+func newRelayerPool(cmd *cobra.Command, clientCtx client.Context, txf tx.Factory) (*gateway.RelayerPool, error) {
+	names, _ := cmd.Flags().GetStringSlice(flagRelayerKeys)
+	if len(names) == 0 {
+		names = []string{clientCtx.FromName}
+	}
+	treasuryName, _ := cmd.Flags().GetString(flagTreasury)
+
+	// 트레저리가 릴레이어 키이기도 하면 시퀀스를 하나로 관리하도록 같은 인스턴스 사용
+	var relayers []*gateway.Relayer
+	var treasury *gateway.Relayer
+	for _, name := range names {
+		relayer, err := gateway.NewRelayerFromKey(clientCtx, txf, name)
+		if err != nil {
+			return nil, err
+		}
+		relayers = append(relayers, relayer)
+		if name == treasuryName {
+			treasury = relayer
+		}
+	}
+
+	var topUp *gateway.TopUp
+	if treasuryName != "" {
+		if treasury == nil {
+			var err error
+			if treasury, err = gateway.NewRelayerFromKey(clientCtx, txf, treasuryName); err != nil {
+				return nil, err
+			}
+		}
+		threshold, _ := cmd.Flags().GetString(flagTopUpMin)
+		amount, _ := cmd.Flags().GetString(flagTopUpAmount)
+		topUp = &gateway.TopUp{Treasury: treasury}
+		var err error
+		if topUp.Threshold, err = sdk.ParseCoinNormalized(threshold); err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", flagTopUpMin, err)
+		}
+		if topUp.Amount, err = sdk.ParseCoinNormalized(amount); err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", flagTopUpAmount, err)
+		}
+	}
+
+	strategyName, _ := cmd.Flags().GetString(flagStrategy)
+	strategy, err := gateway.ParseStrategy(strategyName)
+	if err != nil {
+		return nil, err
+	}
+
+	var metrics *gateway.PoolMetrics
+	if addr, _ := cmd.Flags().GetString(flagMetrics); addr != "" {
+		registry := prometheus.NewRegistry()
+		metrics = gateway.NewPoolMetrics(registry)
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			return nil, err
+		}
+		go func() {
+			_ = http.Serve(listener, promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+		}()
+	}
+	return gateway.NewRelayerPool(clientCtx, relayers, strategy, topUp, metrics)
+}
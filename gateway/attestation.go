@@ -0,0 +1,161 @@
+package gateway
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUntrustedChain     = errors.New("certificate chain does not lead to a pinned attestation root")
+	ErrRevokedCertificate = errors.New("attestation certificate is revoked")
+)
+
+// AttestationRoots are the pinned hardware attestation roots the certificate
+// chain of a device must lead to, and the serial numbers of the attestation
+// certificates revoked by the device makers.
+type AttestationRoots struct {
+	roots   *x509.CertPool
+	revoked map[string]bool
+}
+
+// NewAttestationRoots pins roots and revokes the certificates whose serial
+// numbers, in lowercase hexadecimal, are listed in revoked.
+func NewAttestationRoots(roots []*x509.Certificate, revoked []string) *AttestationRoots {
+	a := &AttestationRoots{roots: x509.NewCertPool(), revoked: make(map[string]bool, len(revoked))}
+	for _, root := range roots {
+		a.roots.AddCert(root)
+	}
+	for _, serial := range revoked {
+		a.revoked[strings.ToLower(serial)] = true
+	}
+	return a
+}
+
+// LoadAttestationRoots pins the roots of a PEM bundle and revokes the
+// certificates of an attestation status list, read from a file or fetched
+// from an http(s) URL in the format of
+// https://android.googleapis.com/attestation/status. No certificate is
+// revoked when statusList is empty.
+func LoadAttestationRoots(rootsFile, statusList string) (*AttestationRoots, error) {
+	bz, err := os.ReadFile(rootsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read attestation roots: %w", err)
+	}
+	var roots []*x509.Certificate
+	for block, rest := pem.Decode(bz); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		root, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse attestation root: %w", err)
+		}
+		roots = append(roots, root)
+	}
+	if len(roots) == 0 {
+		return nil, fmt.Errorf("no certificate in %s", rootsFile)
+	}
+
+	var revoked []string
+	if statusList != "" {
+		if revoked, err = readStatusList(statusList); err != nil {
+			return nil, err
+		}
+	}
+	return NewAttestationRoots(roots, revoked), nil
+}
+
+// readStatusList returns the serial numbers of the certificates an
+// attestation status list does not mark as valid.
+func readStatusList(source string) ([]string, error) {
+	var r io.ReadCloser
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		client := http.Client{Timeout: 30 * time.Second}
+		res, err := client.Get(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch attestation status list: %w", err)
+		}
+		if res.StatusCode != http.StatusOK {
+			res.Body.Close()
+			return nil, fmt.Errorf("failed to fetch attestation status list: %s", res.Status)
+		}
+		r = res.Body
+	} else {
+		f, err := os.Open(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read attestation status list: %w", err)
+		}
+		r = f
+	}
+	defer r.Close()
+
+	var list struct {
+		Entries map[string]struct {
+			Status string `json:"status"`
+		} `json:"entries"`
+	}
+	if err := json.NewDecoder(r).Decode(&list); err != nil {
+		return nil, fmt.Errorf("failed to decode attestation status list: %w", err)
+	}
+	revoked := make([]string, 0, len(list.Entries))
+	for serial, entry := range list.Entries {
+		if entry.Status != "VALID" {
+			revoked = append(revoked, serial)
+		}
+	}
+	return revoked, nil
+}
+
+// Verify checks that the base64 DER certificate chain of a device, leaf
+// first, leads to a pinned root at now and that none of its certificates is
+// revoked.
+func (a *AttestationRoots) Verify(certChain []string, now time.Time) error {
+	if len(certChain) == 0 {
+		return fmt.Errorf("empty certificate chain")
+	}
+	certs := make([]*x509.Certificate, len(certChain))
+	for i, s := range certChain {
+		der, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return fmt.Errorf("failed to decode certificate %d: %w", i, err)
+		}
+		if certs[i], err = x509.ParseCertificate(der); err != nil {
+			return fmt.Errorf("failed to parse certificate %d: %w", i, err)
+		}
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	// 증명 인증서에는 확장 키 용도가 없음
+	chains, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         a.roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUntrustedChain, err)
+	}
+
+	// 제출된 인증서와 검증된 경로의 인증서 모두 폐기 여부 확인
+	for _, chain := range chains {
+		certs = append(certs, chain...)
+	}
+	for _, cert := range certs {
+		if a.revoked[cert.SerialNumber.Text(16)] {
+			return fmt.Errorf("%w: serial %s", ErrRevokedCertificate, cert.SerialNumber.Text(16))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package gateway
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testCert is a certificate with its key.
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// issueCert issues a certificate signed by parent, or self-signed when parent
+// is nil.
+func issueCert(t *testing.T, parent *testCert, serial int64, ca bool, now time.Time) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: big.NewInt(serial).String()},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(time.Hour),
+		IsCA:                  ca,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+	}
+	if ca {
+		template.KeyUsage = x509.KeyUsageCertSign
+	}
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCert{cert: cert, key: key}
+}
+
+func encodeChain(certs ...*testCert) []string {
+	chain := make([]string, len(certs))
+	for i, c := range certs {
+		chain[i] = base64.StdEncoding.EncodeToString(c.cert.Raw)
+	}
+	return chain
+}
+
+func TestAttestationRoots(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	root := issueCert(t, nil, 0x10, true, now)
+	intermediate := issueCert(t, root, 0x11, true, now)
+	leaf := issueCert(t, intermediate, 0x12, false, now)
+	other := issueCert(t, nil, 0x20, true, now)
+	otherLeaf := issueCert(t, other, 0x21, false, now)
+
+	for _, tc := range []struct {
+		name    string
+		chain   []string
+		revoked []string
+		now     time.Time
+		err     error
+	}{
+		{name: "pinned root", chain: encodeChain(leaf, intermediate), now: now},
+		{name: "root included", chain: encodeChain(leaf, intermediate, root), now: now},
+		{name: "missing intermediate", chain: encodeChain(leaf), now: now, err: ErrUntrustedChain},
+		{name: "other root", chain: encodeChain(otherLeaf, other), now: now, err: ErrUntrustedChain},
+		{name: "expired", chain: encodeChain(leaf, intermediate), now: now.Add(2 * time.Hour), err: ErrUntrustedChain},
+		{name: "revoked leaf", chain: encodeChain(leaf, intermediate), revoked: []string{"12"}, now: now, err: ErrRevokedCertificate},
+		{name: "revoked intermediate", chain: encodeChain(leaf, intermediate), revoked: []string{"11"}, now: now, err: ErrRevokedCertificate},
+		{name: "other revoked", chain: encodeChain(leaf, intermediate), revoked: []string{"21"}, now: now},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewAttestationRoots([]*x509.Certificate{root.cert}, tc.revoked).Verify(tc.chain, tc.now)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	err := NewAttestationRoots([]*x509.Certificate{root.cert}, nil).Verify([]string{"not base64"}, now)
+	require.Error(t, err)
+}
+
+func TestLoadAttestationRoots(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	root := issueCert(t, nil, 0x10, true, now)
+	leaf := issueCert(t, root, 0xab, false, now)
+
+	dir := t.TempDir()
+	rootsFile := filepath.Join(dir, "roots.pem")
+	require.NoError(t, os.WriteFile(rootsFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: root.cert.Raw}), 0o600))
+	statusFile := filepath.Join(dir, "status.json")
+	require.NoError(t, os.WriteFile(statusFile, []byte(`{"entries": {
+		"ab": {"status": "REVOKED", "reason": "KEY_COMPROMISE"},
+		"ac": {"status": "VALID"}
+	}}`), 0o600))
+
+	roots, err := LoadAttestationRoots(rootsFile, "")
+	require.NoError(t, err)
+	require.NoError(t, roots.Verify(encodeChain(leaf), now))
+
+	roots, err = LoadAttestationRoots(rootsFile, statusFile)
+	require.NoError(t, err)
+	require.ErrorIs(t, roots.Verify(encodeChain(leaf), now), ErrRevokedCertificate)
+
+	_, err = LoadAttestationRoots(statusFile, "")
+	require.ErrorContains(t, err, "no certificate")
+}
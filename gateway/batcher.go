@@ -0,0 +1,338 @@
+package gateway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	apiv1 "contactical/proto/contactical/contactical/api/v1"
+	realitytypes "contactical/x/reality/types"
+)
+
+// ErrQueueFull is returned when a claim is submitted while the batch queue is
+// at capacity.
+var ErrQueueFull = errors.New("submission queue is full")
+
+// BatchConfig configures how the Batcher packs claims into transactions.
+type BatchConfig struct {
+	// MaxMsgs is the maximum number of claims in one transaction.
+	MaxMsgs int
+	// MaxGas is the gas budget of one transaction. Batches are sized to fit it
+	// using the gas per claim estimated by previous transactions.
+	MaxGas uint64
+	// FlushInterval is how long claims wait for a batch to fill.
+	FlushInterval time.Duration
+	// MaxAttempts is how many times a claim is broadcast before it fails.
+	MaxAttempts int
+	// MaxQueue is the maximum number of claims waiting to be broadcast.
+	MaxQueue int
+	// Retention is how long the status of a finished claim is kept.
+	Retention time.Duration
+}
+
+// DefaultBatchConfig returns the batch configuration used by the gateway
+// command.
+func DefaultBatchConfig() BatchConfig {
+	return BatchConfig{
+		MaxMsgs:       50,
+		MaxGas:        5_000_000,
+		FlushInterval: 2 * time.Second,
+		MaxAttempts:   3,
+		MaxQueue:      10_000,
+		Retention:     time.Hour,
+	}
+}
+
+// Submission is the state of a claim submitted to the Batcher.
+type Submission struct {
+	ID       string
+	Status   apiv1.SubmissionStatus
+	TxHash   string
+	Height   int64
+	Error    string
+	Attempts int
+	// Claim is the claim created on chain, set once the submission is committed.
+	Claim *ClaimResult
+
+	msg         *realitytypes.MsgCreateClaim
+	watch       func(Submission)
+	broadcastAt time.Time
+	finishedAt  time.Time
+}
+
+// final reports whether the submission will not change anymore.
+func (s *Submission) final() bool {
+	return s.Status == apiv1.SubmissionStatus_SUBMISSION_STATUS_COMMITTED ||
+		s.Status == apiv1.SubmissionStatus_SUBMISSION_STATUS_FAILED
+}
+
+// notify passes the new state of the submission to its watcher.
+func (s *Submission) notify() {
+	if s.watch != nil {
+		s.watch(*s)
+	}
+}
+
+// Batcher queues claims and broadcasts them through a RelayerPool as
+// multi-message transactions, either when a batch is full or when the flush
+// interval elapsed. The batches of a flush are broadcast in parallel, up to
+// one per key of the pool.
+type Batcher struct {
+	pool *RelayerPool
+	cfg  BatchConfig
+	now  func() time.Time
+
+	mu          sync.Mutex
+	queue       []*Submission
+	submissions map[string]*Submission
+	byTx        map[string][]*Submission
+	gasPerMsg   uint64
+	full        chan struct{}
+}
+
+// NewBatcher returns a batcher broadcasting through pool. Run must be called
+// for queued claims to be broadcast.
+func NewBatcher(pool *RelayerPool, cfg BatchConfig) *Batcher {
+	return &Batcher{
+		pool:        pool,
+		cfg:         cfg,
+		now:         time.Now,
+		submissions: make(map[string]*Submission),
+		byTx:        make(map[string][]*Submission),
+		full:        make(chan struct{}, 1),
+	}
+}
+
+// Enqueue queues a claim and returns its submission id. When watch is not
+// nil, it is called with every state of the submission, starting with the
+// pending one. It must not block nor call back into the batcher.
+func (b *Batcher) Enqueue(msg *realitytypes.MsgCreateClaim, watch func(Submission)) (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.queue) >= b.cfg.MaxQueue {
+		return "", ErrQueueFull
+	}
+	sub := &Submission{
+		ID:     hex.EncodeToString(id),
+		Status: apiv1.SubmissionStatus_SUBMISSION_STATUS_PENDING,
+		msg:    msg,
+		watch:  watch,
+	}
+	b.queue = append(b.queue, sub)
+	b.submissions[sub.ID] = sub
+	sub.notify()
+	if len(b.queue) >= b.batchSize() {
+		select {
+		case b.full <- struct{}{}:
+		default:
+		}
+	}
+	return sub.ID, nil
+}
+
+// Submission returns a copy of the state of a submission.
+func (b *Batcher) Submission(id string) (Submission, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	sub, ok := b.submissions[id]
+	if !ok {
+		return Submission{}, false
+	}
+	return *sub, true
+}
+
+// Commit records the result of a broadcast transaction for the submissions
+// included in it. txErr is the execution error of a failed transaction and
+// claims are the claims the transaction created.
+func (b *Batcher) Commit(txHash string, height int64, txErr error, claims []ClaimResult) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, sub := range b.byTx[txHash] {
+		if sub.Status != apiv1.SubmissionStatus_SUBMISSION_STATUS_BROADCAST {
+			continue
+		}
+		sub.Height = height
+		sub.finishedAt = b.now()
+		if txErr != nil {
+			sub.Status = apiv1.SubmissionStatus_SUBMISSION_STATUS_FAILED
+			sub.Error = txErr.Error()
+			sub.notify()
+			continue
+		}
+		// 같은 트랜잭션의 클레임 이벤트 중 노드와 센서 해시가 같은 것을 매칭
+		for i, claim := range claims {
+			if claim.NodeID == sub.msg.NodeId && claim.SensorHash == sub.msg.SensorHash {
+				sub.Claim = &claim
+				claims = append(claims[:i:i], claims[i+1:]...)
+				break
+			}
+		}
+		sub.Status = apiv1.SubmissionStatus_SUBMISSION_STATUS_COMMITTED
+		sub.notify()
+	}
+}
+
+// Broadcasted returns the hashes of the transactions broadcast before the
+// given time whose result was not recorded yet.
+func (b *Batcher) Broadcasted(before time.Time) []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var hashes []string
+	for hash, subs := range b.byTx {
+		for _, sub := range subs {
+			if sub.Status == apiv1.SubmissionStatus_SUBMISSION_STATUS_BROADCAST && sub.broadcastAt.Before(before) {
+				hashes = append(hashes, hash)
+				break
+			}
+		}
+	}
+	return hashes
+}
+
+// Run broadcasts the queued claims until ctx is done.
+func (b *Batcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.cfg.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-b.full:
+		}
+		b.Flush(ctx)
+	}
+}
+
+// Flush broadcasts the claims queued so far in batches. Claims failing to
+// broadcast are queued again for the next flush.
+func (b *Batcher) Flush(ctx context.Context) {
+	b.mu.Lock()
+	pending := b.queue
+	b.queue = nil
+	var batches [][]*Submission
+	for len(pending) > 0 {
+		n := min(len(pending), b.batchSize())
+		batches = append(batches, pending[:n:n])
+		pending = pending[n:]
+	}
+	b.mu.Unlock()
+
+	// 릴레이어 키마다 시퀀스가 따로 관리되므로 키 수만큼 배치를 동시에 전송
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, b.pool.Size())
+	for _, batch := range batches {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() { <-slots; wg.Done() }()
+			b.broadcast(ctx, batch)
+		}()
+	}
+	wg.Wait()
+	b.prune()
+}
+
+// batchSize returns how many claims fit in a transaction given the gas per
+// claim of the last transaction.
+func (b *Batcher) batchSize() int {
+	size := b.cfg.MaxMsgs
+	if b.gasPerMsg > 0 {
+		size = min(size, int(b.cfg.MaxGas/b.gasPerMsg))
+	}
+	return max(size, 1)
+}
+
+// broadcast sends batch as one transaction. A rejected batch is split in
+// halves so that a single invalid claim does not fail the others. A batch
+// failing otherwise, e.g. because the node is unreachable, is queued again
+// whole, as is a failing single claim, until it runs out of attempts.
+func (b *Batcher) broadcast(ctx context.Context, batch []*Submission) {
+	res, err := b.pool.Broadcast(ctx, func(relayer sdk.AccAddress) []sdk.Msg {
+		msgs := make([]sdk.Msg, len(batch))
+		for i, sub := range batch {
+			sub.msg.Creator = relayer.String()
+			msgs[i] = sub.msg
+		}
+		return msgs
+	})
+
+	if err != nil && len(batch) > 1 && rejected(res, err) {
+		half := len(batch) / 2
+		b.broadcast(ctx, batch[:half])
+		b.broadcast(ctx, batch[half:])
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err != nil {
+		// 모듈 에러로 거부된 클레임은 다시 보내도 같은 결과이므로 재시도하지 않음
+		_, invalid := moduleError(err)
+		for _, sub := range batch {
+			sub.Attempts++
+			sub.Error = err.Error()
+			if !invalid && sub.Attempts < b.cfg.MaxAttempts {
+				b.queue = append(b.queue, sub)
+				continue
+			}
+			sub.Status = apiv1.SubmissionStatus_SUBMISSION_STATUS_FAILED
+			sub.finishedAt = b.now()
+			sub.notify()
+		}
+		return
+	}
+
+	// 다음 배치 크기 산정을 위해 클레임당 가스 사용량 갱신
+	b.gasPerMsg = uint64(res.GasWanted) / uint64(len(batch))
+	for _, sub := range batch {
+		sub.Attempts++
+		sub.Status = apiv1.SubmissionStatus_SUBMISSION_STATUS_BROADCAST
+		sub.TxHash = res.TxHash
+		sub.Error = ""
+		sub.broadcastAt = b.now()
+		sub.notify()
+	}
+	b.byTx[res.TxHash] = batch
+}
+
+// rejected reports whether a failed transaction was rejected for its
+// messages: by CheckTx with a non-zero ABCI code, or by a module error of its
+// simulation. Other failures, of the transport or of the node, say nothing of
+// the claims of the transaction.
+func rejected(res *sdk.TxResponse, err error) bool {
+	if res != nil && res.Code != 0 {
+		return true
+	}
+	_, ok := moduleError(err)
+	return ok
+}
+
+// prune forgets the submissions committed or failed longer than the
+// retention ago. Broadcast submissions are kept until their transaction
+// result is recorded.
+func (b *Batcher) prune() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	cutoff := b.now().Add(-b.cfg.Retention)
+	for id, sub := range b.submissions {
+		if sub.final() && sub.finishedAt.Before(cutoff) {
+			delete(b.submissions, id)
+		}
+	}
+	for hash, subs := range b.byTx {
+		if _, ok := b.submissions[subs[0].ID]; !ok {
+			delete(b.byTx, hash)
+		}
+	}
+}
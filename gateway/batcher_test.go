@@ -0,0 +1,60 @@
+package gateway
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "contactical/proto/contactical/contactical/api/v1"
+	realitytypes "contactical/x/reality/types"
+)
+
+func TestBatcherPrune(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	cfg := DefaultBatchConfig()
+	b := NewBatcher(nil, cfg)
+	b.now = func() time.Time { return now }
+
+	// 트랜잭션마다 클레임 하나씩 브로드캐스트된 상태로 만듦
+	broadcast := func(txHash string) string {
+		id, err := b.Enqueue(&realitytypes.MsgCreateClaim{NodeId: txHash}, nil)
+		require.NoError(t, err)
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		sub := b.submissions[id]
+		sub.Status = apiv1.SubmissionStatus_SUBMISSION_STATUS_BROADCAST
+		sub.TxHash = txHash
+		sub.broadcastAt = b.now()
+		b.byTx[txHash] = []*Submission{sub}
+		b.queue = nil
+		return id
+	}
+	committed := broadcast("COMMITTED")
+	failed := broadcast("FAILED")
+	dropped := broadcast("DROPPED")
+
+	// 리텐션은 브로드캐스트 시각이 아니라 결과가 기록된 시각부터 셈
+	now = now.Add(cfg.Retention)
+	b.Commit("COMMITTED", 10, nil, nil)
+	b.Commit("FAILED", 10, errors.New("out of gas"), nil)
+	now = now.Add(time.Second)
+	b.prune()
+	for _, id := range []string{committed, failed, dropped} {
+		_, ok := b.Submission(id)
+		require.True(t, ok, id)
+	}
+
+	// 결과가 기록되지 않은 클레임은 리텐션이 지나도 유지
+	now = now.Add(cfg.Retention)
+	b.prune()
+	for _, id := range []string{committed, failed} {
+		_, ok := b.Submission(id)
+		require.False(t, ok, id)
+	}
+	sub, ok := b.Submission(dropped)
+	require.True(t, ok)
+	require.Equal(t, apiv1.SubmissionStatus_SUBMISSION_STATUS_BROADCAST, sub.Status)
+	require.Equal(t, []string{"DROPPED"}, b.Broadcasted(now))
+}
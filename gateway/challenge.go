@@ -0,0 +1,193 @@
+package gateway
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// DefaultChallengeTTL is how long an issued challenge can be used to register.
+const DefaultChallengeTTL = 5 * time.Minute
+
+var (
+	ErrUnknownChallenge = errors.New("unknown or already used challenge")
+	ErrExpiredChallenge = errors.New("challenge expired")
+	ErrChallengeAddress = errors.New("challenge was issued to another address")
+)
+
+var (
+	challengePrefix      = []byte("c/")
+	challengeExpiryIndex = []byte("e/")
+)
+
+// ChallengeStore keeps the challenges handed out to devices until they are
+// consumed by a registration or expire.
+type ChallengeStore interface {
+	// Put records a challenge bound to address until expiresAt.
+	Put(challenge, address string, expiresAt time.Time) error
+	// Take removes a challenge and returns its binding. ok is false when the
+	// challenge is unknown, e.g. because it was already taken.
+	Take(challenge string) (address string, expiresAt time.Time, ok bool, err error)
+	// Prune removes the challenges expired at now.
+	Prune(now time.Time) error
+}
+
+// dbChallengeStore is a ChallengeStore over a cosmos-db database. Challenges
+// are stored under c/<challenge> and indexed under e/<expiry><challenge> to be
+// pruned in expiry order.
+type dbChallengeStore struct {
+	mu sync.Mutex
+	db dbm.DB
+}
+
+// NewMemChallengeStore returns a ChallengeStore kept in memory.
+func NewMemChallengeStore() ChallengeStore {
+	return &dbChallengeStore{db: dbm.NewMemDB()}
+}
+
+// NewLevelDBChallengeStore returns a ChallengeStore persisted in a LevelDB
+// database under dir, so that issued challenges survive gateway restarts.
+func NewLevelDBChallengeStore(dir string) (ChallengeStore, error) {
+	db, err := dbm.NewGoLevelDB("challenges", dir, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open challenge store: %w", err)
+	}
+	return &dbChallengeStore{db: db}, nil
+}
+
+func (s *dbChallengeStore) Put(challenge, address string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value := binary.BigEndian.AppendUint64(nil, uint64(expiresAt.Unix()))
+	value = append(value, address...)
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(challengeKey(challenge), value); err != nil {
+		return err
+	}
+	if err := batch.Set(expiryKey(expiresAt, challenge), []byte{}); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+func (s *dbChallengeStore) Take(challenge string) (string, time.Time, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, err := s.db.Get(challengeKey(challenge))
+	if err != nil || len(value) < 8 {
+		return "", time.Time{}, false, err
+	}
+	expiresAt := time.Unix(int64(binary.BigEndian.Uint64(value)), 0)
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Delete(challengeKey(challenge)); err != nil {
+		return "", time.Time{}, false, err
+	}
+	if err := batch.Delete(expiryKey(expiresAt, challenge)); err != nil {
+		return "", time.Time{}, false, err
+	}
+	if err := batch.Write(); err != nil {
+		return "", time.Time{}, false, err
+	}
+	return string(value[8:]), expiresAt, true, nil
+}
+
+func (s *dbChallengeStore) Prune(now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// 만료 시각 순 인덱스를 now까지 순회하며 삭제
+	end := expiryKey(now, "")
+	it, err := s.db.Iterator(challengeExpiryIndex, end)
+	if err != nil {
+		return err
+	}
+	var expired [][]byte
+	for ; it.Valid(); it.Next() {
+		expired = append(expired, it.Key())
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	for _, key := range expired {
+		challenge := string(key[len(challengeExpiryIndex)+8:])
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+		if err := batch.Delete(challengeKey(challenge)); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+func challengeKey(challenge string) []byte {
+	return append(append([]byte{}, challengePrefix...), challenge...)
+}
+
+func expiryKey(expiresAt time.Time, challenge string) []byte {
+	key := binary.BigEndian.AppendUint64(append([]byte{}, challengeExpiryIndex...), uint64(expiresAt.Unix()))
+	return append(key, challenge...)
+}
+
+// Challenges issues single use registration challenges bound to an address.
+type Challenges struct {
+	store ChallengeStore
+	ttl   time.Duration
+	now   func() time.Time
+}
+
+// NewChallenges returns challenges kept in store and valid for ttl.
+func NewChallenges(store ChallengeStore, ttl time.Duration) *Challenges {
+	return &Challenges{store: store, ttl: ttl, now: time.Now}
+}
+
+// Issue returns a fresh base64 challenge for address and its expiry.
+func (c *Challenges) Issue(address string) (string, time.Time, error) {
+	now := c.now()
+	if err := c.store.Prune(now); err != nil {
+		return "", time.Time{}, err
+	}
+	nonce := make([]byte, 32)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", time.Time{}, err
+	}
+	challenge := base64.StdEncoding.EncodeToString(nonce)
+	expiresAt := now.Add(c.ttl)
+	if err := c.store.Put(challenge, address, expiresAt); err != nil {
+		return "", time.Time{}, err
+	}
+	return challenge, expiresAt, nil
+}
+
+// Consume redeems a challenge for address. A challenge can be consumed only
+// once, whether or not the registration using it succeeds.
+func (c *Challenges) Consume(challenge, address string) error {
+	bound, expiresAt, ok, err := c.store.Take(challenge)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrUnknownChallenge
+	}
+	if !c.now().Before(expiresAt) {
+		return ErrExpiredChallenge
+	}
+	if bound != address {
+		return ErrChallengeAddress
+	}
+	return nil
+}
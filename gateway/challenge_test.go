@@ -0,0 +1,50 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallenges(t *testing.T) {
+	for name, newStore := range map[string]func(t *testing.T) ChallengeStore{
+		"memory": func(*testing.T) ChallengeStore { return NewMemChallengeStore() },
+		"leveldb": func(t *testing.T) ChallengeStore {
+			store, err := NewLevelDBChallengeStore(t.TempDir())
+			require.NoError(t, err)
+			return store
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			now := time.Unix(1_700_000_000, 0)
+			challenges := NewChallenges(newStore(t), time.Minute)
+			challenges.now = func() time.Time { return now }
+
+			challenge, expiresAt, err := challenges.Issue("alice")
+			require.NoError(t, err)
+			require.Equal(t, now.Add(time.Minute), expiresAt)
+
+			require.ErrorIs(t, challenges.Consume("unknown", "alice"), ErrUnknownChallenge)
+			require.NoError(t, challenges.Consume(challenge, "alice"))
+			require.ErrorIs(t, challenges.Consume(challenge, "alice"), ErrUnknownChallenge)
+
+			challenge, _, err = challenges.Issue("alice")
+			require.NoError(t, err)
+			require.ErrorIs(t, challenges.Consume(challenge, "bob"), ErrChallengeAddress)
+
+			challenge, _, err = challenges.Issue("alice")
+			require.NoError(t, err)
+			now = now.Add(time.Minute)
+			require.ErrorIs(t, challenges.Consume(challenge, "alice"), ErrExpiredChallenge)
+
+			// expired challenges are pruned when new ones are issued
+			challenge, _, err = challenges.Issue("alice")
+			require.NoError(t, err)
+			now = now.Add(2 * time.Minute)
+			_, _, err = challenges.Issue("alice")
+			require.NoError(t, err)
+			require.ErrorIs(t, challenges.Consume(challenge, "alice"), ErrUnknownChallenge)
+		})
+	}
+}
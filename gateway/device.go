@@ -0,0 +1,65 @@
+package gateway
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+)
+
+// devicePubKey returns the PEM encoded public key of the leaf certificate of
+// an attestation chain, which is the key the device signs its readings with.
+func devicePubKey(certChain []string) (string, error) {
+	if len(certChain) == 0 {
+		return "", fmt.Errorf("empty certificate chain")
+	}
+	der, err := base64.StdEncoding.DecodeString(certChain[0])
+	if err != nil {
+		return "", fmt.Errorf("failed to decode leaf certificate: %w", err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse leaf certificate: %w", err)
+	}
+	pub, err := x509.MarshalPKIXPublicKey(leaf.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("unsupported device key: %w", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pub})), nil
+}
+
+var (
+	latPattern = regexp.MustCompile(`(?i)\blat(?:itude)?\s*[:=]\s*(-?\d+(?:\.\d+)?)`)
+	lonPattern = regexp.MustCompile(`(?i)\b(?:lon|lng|longitude)\s*[:=]\s*(-?\d+(?:\.\d+)?)`)
+)
+
+// parseLocation extracts the coordinates of a reading payload such as
+// "lat: 37.5, lon: 127.0" in micro degrees, the unit claims are stored in.
+func parseLocation(payload string) (lat, lon int64, ok bool) {
+	latMatch := latPattern.FindStringSubmatch(payload)
+	lonMatch := lonPattern.FindStringSubmatch(payload)
+	if latMatch == nil || lonMatch == nil {
+		return 0, 0, false
+	}
+	latDeg, err := strconv.ParseFloat(latMatch[1], 64)
+	if err != nil || latDeg < -90 || latDeg > 90 {
+		return 0, 0, false
+	}
+	lonDeg, err := strconv.ParseFloat(lonMatch[1], 64)
+	if err != nil || lonDeg < -180 || lonDeg > 180 {
+		return 0, 0, false
+	}
+	return int64(math.Round(latDeg * 1e6)), int64(math.Round(lonDeg * 1e6)), true
+}
+
+// payloadHash returns the hex SHA-256 digest recorded as the claim sensor and
+// GNSS hashes.
+func payloadHash(payload string) string {
+	digest := sha256.Sum256([]byte(payload))
+	return hex.EncodeToString(digest[:])
+}
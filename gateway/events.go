@@ -0,0 +1,131 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/gogoproto/proto"
+
+	realitytypes "contactical/x/reality/types"
+)
+
+// DefaultReconcileInterval is how long a ClaimWatcher waits for the result
+// of a broadcast transaction before looking it up.
+const DefaultReconcileInterval = 10 * time.Second
+
+// claimCreatedQuery selects the transactions creating claims.
+var claimCreatedQuery = fmt.Sprintf("tm.event='Tx' AND %s.node_id EXISTS", proto.MessageName(&realitytypes.EventClaimCreated{}))
+
+// ClaimResult is a claim created on chain, as reported by its
+// EventClaimCreated event.
+type ClaimResult struct {
+	ClaimID          uint64
+	NodeID           string
+	SensorHash       string
+	TrustScore       int64
+	RewardMultiplier int64
+	RewardPoints     int64
+}
+
+// claimResults returns the claims created by the events of a transaction.
+func claimResults(events []abci.Event) []ClaimResult {
+	var claims []ClaimResult
+	for _, event := range events {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		created, ok := msg.(*realitytypes.EventClaimCreated)
+		if !ok {
+			continue
+		}
+		claims = append(claims, ClaimResult{
+			ClaimID:          created.ClaimId,
+			NodeID:           created.NodeId,
+			SensorHash:       created.SensorHash,
+			TrustScore:       created.TrustScore,
+			RewardMultiplier: created.RewardMultiplier,
+			RewardPoints:     created.RewardPoints,
+		})
+	}
+	return claims
+}
+
+// commitTx looks a broadcast transaction up and records its result in the
+// batcher. It returns false when the transaction is not in a block yet.
+func commitTx(clientCtx client.Context, batcher *Batcher, txHash string) bool {
+	res, err := authtx.QueryTx(clientCtx, txHash)
+	if err != nil {
+		return false
+	}
+	var txErr error
+	if res.Code != 0 {
+		txErr = errorsmod.ABCIError(res.Codespace, res.Code, res.RawLog)
+	}
+	batcher.Commit(res.TxHash, res.Height, txErr, claimResults(res.Events))
+	return true
+}
+
+// ClaimWatcher records the results of the claims relayed by a Batcher as the
+// chain commits them. Results come from a CometBFT websocket subscription to
+// EventClaimCreated events. Transactions whose result was missed, e.g. while the
+// websocket was reconnecting or because the transaction failed, are looked up
+// once they are older than the reconcile interval.
+type ClaimWatcher struct {
+	clientCtx client.Context
+	batcher   *Batcher
+	remote    string
+	interval  time.Duration
+}
+
+// NewClaimWatcher returns a watcher subscribing to the CometBFT RPC endpoint
+// remote and reconciling missed transactions every interval.
+func NewClaimWatcher(clientCtx client.Context, batcher *Batcher, remote string, interval time.Duration) *ClaimWatcher {
+	return &ClaimWatcher{clientCtx: clientCtx, batcher: batcher, remote: remote, interval: interval}
+}
+
+// Run watches the chain until ctx is done.
+func (w *ClaimWatcher) Run(ctx context.Context) error {
+	rpc, err := rpchttp.New(w.remote, "/websocket")
+	if err != nil {
+		return err
+	}
+	if err := rpc.Start(); err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", w.remote, err)
+	}
+	defer func() { _ = rpc.Stop() }()
+
+	// 웹소켓이 재연결되면 구독도 자동으로 다시 등록됨
+	events, err := rpc.Subscribe(ctx, "contactical-gateway", claimCreatedQuery, 100)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to claim events: %w", err)
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-events:
+			data, ok := event.Data.(cmttypes.EventDataTx)
+			if !ok {
+				continue
+			}
+			txHash := fmt.Sprintf("%X", cmttypes.Tx(data.Tx).Hash())
+			w.batcher.Commit(txHash, data.Height, nil, claimResults(data.Result.Events))
+		case now := <-ticker.C:
+			for _, txHash := range w.batcher.Broadcasted(now.Add(-w.interval)) {
+				commitTx(w.clientCtx, w.batcher, txHash)
+			}
+		}
+	}
+}
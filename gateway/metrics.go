@@ -0,0 +1,89 @@
+package gateway
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PoolMetrics are the Prometheus metrics of a RelayerPool, labelled by the
+// name of the relayer key. A nil *PoolMetrics records nothing.
+type PoolMetrics struct {
+	broadcasts *prometheus.CounterVec
+	latency    *prometheus.HistogramVec
+	inFlight   *prometheus.GaugeVec
+	balance    *prometheus.GaugeVec
+	topUps     *prometheus.CounterVec
+}
+
+// NewPoolMetrics returns the pool metrics registered in reg.
+func NewPoolMetrics(reg prometheus.Registerer) *PoolMetrics {
+	m := &PoolMetrics{
+		broadcasts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "contactical",
+			Subsystem: "gateway_relayer",
+			Name:      "broadcasts_total",
+			Help:      "Transactions broadcast by a relayer key, by result.",
+		}, []string{"key", "result"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "contactical",
+			Subsystem: "gateway_relayer",
+			Name:      "broadcast_seconds",
+			Help:      "Time to simulate, sign and broadcast a transaction with a relayer key.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"key"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "contactical",
+			Subsystem: "gateway_relayer",
+			Name:      "in_flight",
+			Help:      "Transactions being broadcast with a relayer key.",
+		}, []string{"key"}),
+		balance: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "contactical",
+			Subsystem: "gateway_relayer",
+			Name:      "balance",
+			Help:      "Balance of a relayer key in the top-up denom.",
+		}, []string{"key", "denom"}),
+		topUps: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "contactical",
+			Subsystem: "gateway_relayer",
+			Name:      "top_ups_total",
+			Help:      "Top-ups of a relayer key from the treasury.",
+		}, []string{"key"}),
+	}
+	reg.MustRegister(m.broadcasts, m.latency, m.inFlight, m.balance, m.topUps)
+	return m
+}
+
+func (m *PoolMetrics) observeBroadcast(key string, start time.Time, err error) {
+	if m == nil {
+		return
+	}
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	m.broadcasts.WithLabelValues(key, result).Inc()
+	m.latency.WithLabelValues(key).Observe(time.Since(start).Seconds())
+}
+
+func (m *PoolMetrics) setInFlight(key string, n int64) {
+	if m == nil {
+		return
+	}
+	m.inFlight.WithLabelValues(key).Set(float64(n))
+}
+
+func (m *PoolMetrics) setBalance(key, denom string, amount float64) {
+	if m == nil {
+		return
+	}
+	m.balance.WithLabelValues(key, denom).Set(amount)
+}
+
+func (m *PoolMetrics) toppedUp(key string) {
+	if m == nil {
+		return
+	}
+	m.topUps.WithLabelValues(key).Inc()
+}
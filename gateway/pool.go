@@ -0,0 +1,217 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Strategy selects the relayer key of a transaction in a RelayerPool.
+type Strategy string
+
+const (
+	// StrategyRoundRobin uses the keys in turn.
+	StrategyRoundRobin Strategy = "round-robin"
+	// StrategyLeastLoaded uses the key with the fewest transactions being
+	// broadcast.
+	StrategyLeastLoaded Strategy = "least-loaded"
+)
+
+// ParseStrategy returns the strategy named s.
+func ParseStrategy(s string) (Strategy, error) {
+	switch strategy := Strategy(s); strategy {
+	case StrategyRoundRobin, StrategyLeastLoaded:
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("unknown relayer strategy %q, expected %s or %s", s, StrategyRoundRobin, StrategyLeastLoaded)
+	}
+}
+
+// TopUp configures the funding of the relayer keys of a pool from a
+// treasury key.
+type TopUp struct {
+	Treasury *Relayer
+	// Threshold is the balance under which a key is topped up.
+	Threshold sdk.Coin
+	// Amount is sent to a key whose balance is under the threshold.
+	Amount sdk.Coin
+}
+
+// RelayerPool broadcasts transactions with several relayer keys. Every key
+// tracks its own account sequence, so transactions of different keys are
+// broadcast in parallel.
+type RelayerPool struct {
+	relayers []*Relayer
+	strategy Strategy
+	topUp    *TopUp
+	metrics  *PoolMetrics
+	bank     banktypes.QueryClient
+
+	mu   sync.Mutex
+	next int
+	load []int64
+}
+
+// NewRelayerPool returns a pool of relayers selected with strategy. topUp and
+// metrics are optional.
+func NewRelayerPool(clientCtx client.Context, relayers []*Relayer, strategy Strategy, topUp *TopUp, metrics *PoolMetrics) (*RelayerPool, error) {
+	if len(relayers) == 0 {
+		return nil, fmt.Errorf("relayer pool has no keys")
+	}
+	if _, err := ParseStrategy(string(strategy)); err != nil {
+		return nil, err
+	}
+	return &RelayerPool{
+		relayers: relayers,
+		strategy: strategy,
+		topUp:    topUp,
+		metrics:  metrics,
+		bank:     banktypes.NewQueryClient(clientCtx),
+		load:     make([]int64, len(relayers)),
+	}, nil
+}
+
+// Size returns the number of keys of the pool.
+func (p *RelayerPool) Size() int {
+	return len(p.relayers)
+}
+
+// Contains reports whether addr is a key of the pool.
+func (p *RelayerPool) Contains(addr sdk.AccAddress) bool {
+	return p.index(addr) >= 0
+}
+
+func (p *RelayerPool) index(addr sdk.AccAddress) int {
+	for i, r := range p.relayers {
+		if r.Address().Equals(addr) {
+			return i
+		}
+	}
+	return -1
+}
+
+// acquire selects a relayer with the strategy of the pool and counts the
+// transaction in its load until release is called.
+func (p *RelayerPool) acquire() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	i := 0
+	switch p.strategy {
+	case StrategyLeastLoaded:
+		for j := range p.load {
+			if p.load[j] < p.load[i] {
+				i = j
+			}
+		}
+	default:
+		i = p.next
+		p.next = (p.next + 1) % len(p.relayers)
+	}
+	p.hold(i)
+	return i
+}
+
+func (p *RelayerPool) hold(i int) {
+	p.load[i]++
+	p.metrics.setInFlight(p.relayers[i].Name(), p.load[i])
+}
+
+func (p *RelayerPool) release(i int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.load[i]--
+	p.metrics.setInFlight(p.relayers[i].Name(), p.load[i])
+}
+
+// Broadcast broadcasts the messages returned by build for the address of the
+// selected relayer key.
+func (p *RelayerPool) Broadcast(ctx context.Context, build func(relayer sdk.AccAddress) []sdk.Msg) (*sdk.TxResponse, error) {
+	i := p.acquire()
+	defer p.release(i)
+	return p.broadcast(ctx, p.relayers[i], build(p.relayers[i].Address()))
+}
+
+// BroadcastFrom broadcasts msgs with the key of addr.
+func (p *RelayerPool) BroadcastFrom(ctx context.Context, addr sdk.AccAddress, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	i := p.index(addr)
+	if i < 0 {
+		return nil, fmt.Errorf("%s is not a relayer key", addr)
+	}
+	p.mu.Lock()
+	p.hold(i)
+	p.mu.Unlock()
+	defer p.release(i)
+	return p.broadcast(ctx, p.relayers[i], msgs)
+}
+
+func (p *RelayerPool) broadcast(ctx context.Context, r *Relayer, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	start := time.Now()
+	res, err := r.Broadcast(ctx, msgs...)
+	p.metrics.observeBroadcast(r.Name(), start, err)
+	return res, err
+}
+
+// TopUp sends the top-up amount from the treasury to the keys whose balance
+// is under the threshold, in a single transaction. It also records the
+// balances of the keys. The treasury may be a key of the pool, in which case
+// it must be the same Relayer so that its sequence is tracked once.
+func (p *RelayerPool) TopUp(ctx context.Context) error {
+	if p.topUp == nil {
+		return nil
+	}
+	treasury := p.topUp.Treasury.Address()
+	var msgs []sdk.Msg
+	var funded []string
+	for _, r := range p.relayers {
+		if r.Address().Equals(treasury) {
+			continue
+		}
+		res, err := p.bank.Balance(ctx, &banktypes.QueryBalanceRequest{
+			Address: r.Address().String(),
+			Denom:   p.topUp.Threshold.Denom,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to query balance of %s: %w", r.Name(), err)
+		}
+		balance, _ := res.Balance.Amount.ToLegacyDec().Float64()
+		p.metrics.setBalance(r.Name(), res.Balance.Denom, balance)
+		if res.Balance.IsGTE(p.topUp.Threshold) {
+			continue
+		}
+		msgs = append(msgs, banktypes.NewMsgSend(treasury, r.Address(), sdk.NewCoins(p.topUp.Amount)))
+		funded = append(funded, r.Name())
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if _, err := p.topUp.Treasury.Broadcast(ctx, msgs...); err != nil {
+		return fmt.Errorf("failed to top up relayer keys: %w", err)
+	}
+	for _, name := range funded {
+		p.metrics.toppedUp(name)
+	}
+	return nil
+}
+
+// Run tops the keys up every interval until ctx is done. Errors are passed
+// to onError.
+func (p *RelayerPool) Run(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.TopUp(ctx); err != nil {
+				onError(err)
+			}
+		}
+	}
+}
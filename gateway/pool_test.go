@@ -0,0 +1,44 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testPool(t *testing.T, strategy Strategy) *RelayerPool {
+	t.Helper()
+	var relayers []*Relayer
+	for _, name := range []string{"a", "b", "c"} {
+		clientCtx := client.Context{FromName: name, FromAddress: sdk.AccAddress(name)}
+		relayers = append(relayers, &Relayer{clientCtx: clientCtx})
+	}
+	pool, err := NewRelayerPool(client.Context{}, relayers, strategy, nil, nil)
+	require.NoError(t, err)
+	return pool
+}
+
+func TestRelayerPoolStrategies(t *testing.T) {
+	pool := testPool(t, StrategyRoundRobin)
+	var picked []int
+	for range 4 {
+		picked = append(picked, pool.acquire())
+	}
+	require.Equal(t, []int{0, 1, 2, 0}, picked)
+
+	pool = testPool(t, StrategyLeastLoaded)
+	require.Equal(t, 0, pool.acquire())
+	require.Equal(t, 1, pool.acquire())
+	pool.release(0)
+	require.Equal(t, 0, pool.acquire())
+	require.Equal(t, 2, pool.acquire())
+	require.Equal(t, []int64{1, 1, 1}, pool.load)
+
+	require.True(t, pool.Contains(sdk.AccAddress("b")))
+	require.False(t, pool.Contains(sdk.AccAddress("d")))
+
+	_, err := ParseStrategy("random")
+	require.Error(t, err)
+}
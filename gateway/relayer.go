@@ -0,0 +1,171 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	realitytypes "contactical/x/reality/types"
+)
+
+// Relayer signs and broadcasts gateway transactions with a single key of the
+// Cosmos keyring. Broadcasts are serialized and the account sequence is
+// tracked locally, so that several transactions can be sent within a block.
+type Relayer struct {
+	clientCtx client.Context
+	txf       tx.Factory
+
+	mu         sync.Mutex
+	loaded     bool
+	accountNum uint64
+	sequence   uint64
+}
+
+// NewRelayer returns a relayer signing with the key clientCtx.FromName.
+// clientCtx must carry a keyring, a node client and the from address.
+func NewRelayer(clientCtx client.Context, txf tx.Factory) (*Relayer, error) {
+	if clientCtx.FromName == "" || clientCtx.FromAddress.Empty() {
+		return nil, fmt.Errorf("relayer key is not set")
+	}
+	if clientCtx.Keyring == nil {
+		return nil, fmt.Errorf("relayer keyring is not set")
+	}
+	txf = txf.
+		WithKeybase(clientCtx.Keyring).
+		WithFromName(clientCtx.FromName).
+		WithTxConfig(clientCtx.TxConfig).
+		WithAccountRetriever(clientCtx.AccountRetriever).
+		WithChainID(clientCtx.ChainID)
+	return &Relayer{clientCtx: clientCtx, txf: txf}, nil
+}
+
+// NewRelayerFromKey returns a relayer signing with the key name of the
+// keyring of clientCtx.
+func NewRelayerFromKey(clientCtx client.Context, txf tx.Factory, name string) (*Relayer, error) {
+	if clientCtx.Keyring == nil {
+		return nil, fmt.Errorf("relayer keyring is not set")
+	}
+	record, err := clientCtx.Keyring.Key(name)
+	if err != nil {
+		return nil, fmt.Errorf("relayer key %s: %w", name, err)
+	}
+	addr, err := record.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+	return NewRelayer(clientCtx.WithFromName(name).WithFromAddress(addr), txf)
+}
+
+// Name returns the name of the relayer key in the keyring.
+func (r *Relayer) Name() string {
+	return r.clientCtx.FromName
+}
+
+// Address returns the relayer account address.
+func (r *Relayer) Address() sdk.AccAddress {
+	return r.clientCtx.FromAddress
+}
+
+// maxSequenceRetries bounds how often a transaction is re-signed after the
+// account sequence turned out to be stale.
+const maxSequenceRetries = 3
+
+// Broadcast signs msgs into one transaction with simulated gas and broadcasts
+// it in sync mode. It returns the transaction response, with GasWanted set to
+// the gas limit of the transaction, once the transaction passed CheckTx. On an
+// account sequence mismatch the account is reloaded and the transaction is
+// signed again.
+func (r *Relayer) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for attempt := 0; ; attempt++ {
+		res, err := r.broadcast(ctx, msgs)
+		if err == nil || !isWrongSequence(err) || attempt == maxSequenceRetries {
+			return res, err
+		}
+		// 다른 프로세스가 같은 키로 전송했을 수 있으므로 계정을 다시 조회
+		r.loaded = false
+	}
+}
+
+func (r *Relayer) broadcast(ctx context.Context, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	if !r.loaded {
+		accountNum, sequence, err := r.clientCtx.AccountRetriever.GetAccountNumberSequence(r.clientCtx, r.Address())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load relayer account: %w", err)
+		}
+		r.accountNum, r.sequence, r.loaded = accountNum, sequence, true
+	}
+
+	txf := r.txf.WithAccountNumber(r.accountNum).WithSequence(r.sequence)
+	_, gas, err := tx.CalculateGas(r.clientCtx, txf, msgs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate tx: %w", err)
+	}
+	txf = txf.WithGas(gas)
+
+	builder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(ctx, txf, r.clientCtx.FromName, builder, true); err != nil {
+		return nil, fmt.Errorf("failed to sign tx: %w", err)
+	}
+	txBytes, err := r.clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.clientCtx.BroadcastTxSync(txBytes)
+	if err != nil {
+		r.loaded = false
+		return nil, fmt.Errorf("failed to broadcast tx: %w", err)
+	}
+	if res.Code != 0 {
+		return res, fmt.Errorf("tx %s rejected: %w", res.TxHash, errorsmod.ABCIError(res.Codespace, res.Code, res.RawLog))
+	}
+	r.sequence++
+	res.GasWanted = int64(gas)
+	return res, nil
+}
+
+// moduleErrors are the x/reality errors passed on to gateway clients with
+// their gRPC code. A claim rejected with one of them is not retried.
+var moduleErrors = []*errorsmod.Error{
+	realitytypes.ErrUnknownNode,
+	realitytypes.ErrNodeBanned,
+	realitytypes.ErrNullifierReused,
+	realitytypes.ErrMissingProof,
+	realitytypes.ErrAttestationFailed,
+	realitytypes.ErrInsufficientBond,
+	realitytypes.ErrInvalidBond,
+	realitytypes.ErrExpiredTimestamp,
+	realitytypes.ErrInvalidSignature,
+	realitytypes.ErrPluginVerification,
+}
+
+// moduleError returns the x/reality error causing err. Simulation errors only
+// carry the message of the error, so they are matched by description too.
+func moduleError(err error) (*errorsmod.Error, bool) {
+	for _, target := range moduleErrors {
+		if errors.Is(err, target) || strings.Contains(err.Error(), target.Error()) {
+			return target, true
+		}
+	}
+	return nil, false
+}
+
+// isWrongSequence reports whether err is caused by a stale account sequence,
+// either from CheckTx or from the ante handler run by the simulation.
+func isWrongSequence(err error) bool {
+	return errors.Is(err, sdkerrors.ErrWrongSequence) || strings.Contains(err.Error(), sdkerrors.ErrWrongSequence.Error())
+}
@@ -0,0 +1,46 @@
+package gateway
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	realitytypes "contactical/x/reality/types"
+)
+
+func TestChainStatus(t *testing.T) {
+	// DeliverTx 결과는 코드로, 시뮬레이션 실패는 메시지로만 전달됨
+	checkTx := fmt.Errorf("tx ABCD rejected: %w", errorsmod.ABCIError(realitytypes.ModuleName, realitytypes.ErrNullifierReused.ABCICode(), "node already registered"))
+	simulation := errors.New("failed to simulate tx: rpc error: code = Unknown desc = failed to execute message; message index: 0: node id abc: node is not registered With gas wanted: '0' and gas used: '1234' ")
+
+	moduleErr, ok := moduleError(checkTx)
+	require.True(t, ok)
+	require.Equal(t, realitytypes.ErrNullifierReused, moduleErr)
+	require.Equal(t, codes.AlreadyExists, status.Code(chainStatus(checkTx, "registration failed")))
+	require.Equal(t, codes.NotFound, status.Code(chainStatus(simulation, "registration failed")))
+
+	outOfGas := fmt.Errorf("tx ABCD rejected: %w", errorsmod.ABCIError(sdkerrors.RootCodespace, sdkerrors.ErrOutOfGas.ABCICode(), "out of gas"))
+	_, ok = moduleError(outOfGas)
+	require.False(t, ok)
+	require.Equal(t, codes.Aborted, status.Code(chainStatus(outOfGas, "registration failed")))
+	require.True(t, isWrongSequence(fmt.Errorf("tx ABCD rejected: %w", errorsmod.ABCIError(sdkerrors.RootCodespace, sdkerrors.ErrWrongSequence.ABCICode(), "account sequence mismatch"))))
+}
+
+func TestRejected(t *testing.T) {
+	checkTx := fmt.Errorf("tx ABCD rejected: %w", errorsmod.ABCIError(sdkerrors.RootCodespace, sdkerrors.ErrInsufficientFee.ABCICode(), "insufficient fee"))
+	simulation := errors.New("failed to simulate tx: rpc error: code = Unknown desc = failed to execute message; message index: 1: node id abc: node is not registered")
+
+	// 메시지로 인해 거부된 경우에만 배치를 나눔
+	require.True(t, rejected(&sdk.TxResponse{Code: sdkerrors.ErrInsufficientFee.ABCICode()}, checkTx))
+	require.True(t, rejected(nil, simulation))
+	require.False(t, rejected(nil, errors.New("failed to simulate tx: rpc error: code = Unavailable desc = connection refused")))
+	require.False(t, rejected(nil, errors.New("failed to broadcast tx: post failed: context deadline exceeded")))
+	require.False(t, rejected(nil, errors.New("failed to load relayer account: connection refused")))
+}
@@ -0,0 +1,252 @@
+// Package gateway serves the ContacticalService device API. It checks device
+// attestations and signatures off-chain and relays the requests to the chain
+// as x/reality transactions signed by a relayer key.
+package gateway
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "contactical/proto/contactical/contactical/api/v1"
+	realitykeeper "contactical/x/reality/keeper"
+	realitytypes "contactical/x/reality/types"
+)
+
+// Server implements apiv1.ContacticalServiceServer on top of a Relayer.
+type Server struct {
+	apiv1.UnimplementedContacticalServiceServer
+
+	clientCtx  client.Context
+	pool       *RelayerPool
+	challenges *Challenges
+	roots      *AttestationRoots
+	batcher    *Batcher
+	sessions   *streamSessions
+	query      realitytypes.QueryClient
+	now        func() time.Time
+}
+
+var _ apiv1.ContacticalServiceServer = (*Server)(nil)
+
+// NewServer returns a gateway server relaying registrations through pool and
+// claims through batcher, issuing registration challenges from challenges,
+// accepting the device certificate chains leading to roots and reading chain
+// state through clientCtx.
+func NewServer(clientCtx client.Context, pool *RelayerPool, challenges *Challenges, roots *AttestationRoots, batcher *Batcher) *Server {
+	return &Server{
+		clientCtx:  clientCtx,
+		pool:       pool,
+		challenges: challenges,
+		roots:      roots,
+		batcher:    batcher,
+		sessions:   newStreamSessions(),
+		query:      realitytypes.NewQueryClient(clientCtx),
+		now:        time.Now,
+	}
+}
+
+// GetChallenge issues a single use challenge for the creator to put in the
+// attestation of the device key it registers.
+func (s *Server) GetChallenge(_ context.Context, req *apiv1.GetChallengeRequest) (*apiv1.GetChallengeResponse, error) {
+	creator, err := sdk.AccAddressFromBech32(req.GetCreatorAddress())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %v", err)
+	}
+	challenge, expiresAt, err := s.challenges.Issue(creator.String())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue challenge: %v", err)
+	}
+	return &apiv1.GetChallengeResponse{Challenge: challenge, ExpiresAt: expiresAt.Unix()}, nil
+}
+
+// RegisterNode redeems the challenge issued to the creator, verifies that the
+// attestation chain of a device leads to a pinned root without revoked
+// certificates and attests the challenge, and relays a MsgRegisterNode for
+// the creator. The device key is taken from the leaf certificate.
+// Registrations of other accounts than the relayer keys are executed through
+// an x/authz grant of the creator to the relayer keys. The part of the
+// minimum bond of the TEE trust tier the creator has not posted yet is posted
+// with the registration, from the balance of the creator.
+func (s *Server) RegisterNode(ctx context.Context, req *apiv1.RegisterNodeRequest) (*apiv1.RegisterNodeResponse, error) {
+	creator, err := sdk.AccAddressFromBech32(req.GetCreatorAddress())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %v", err)
+	}
+	if req.GetChallenge() == "" {
+		return nil, status.Error(codes.InvalidArgument, "challenge is required")
+	}
+	if err := s.challenges.Consume(req.GetChallenge(), creator.String()); err != nil {
+		if errors.Is(err, ErrUnknownChallenge) || errors.Is(err, ErrExpiredChallenge) || errors.Is(err, ErrChallengeAddress) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to redeem challenge: %v", err)
+	}
+
+	// 온체인은 개발 모드로 실패를 허용하므로 게이트웨이에서 엄격하게 검증
+	if err := s.roots.Verify(req.GetCertChain(), s.now()); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "attestation chain verification failed: %v", err)
+	}
+	info, err := realitytypes.VerifyAttestation(req.GetCertChain(), req.GetChallenge())
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "attestation verification failed: %v", err)
+	}
+	if base64.StdEncoding.EncodeToString(info.Challenge) != req.GetChallenge() {
+		return nil, status.Error(codes.PermissionDenied, "attestation challenge mismatch")
+	}
+	if info.SecurityLevel < realitytypes.SecurityLevelTEE {
+		return nil, status.Error(codes.PermissionDenied, "device key is not hardware backed")
+	}
+	pubKey, err := devicePubKey(req.GetCertChain())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	bond, err := s.registrationBond(ctx, creator.String())
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &realitytypes.MsgRegisterNode{
+		Creator:   creator.String(),
+		PubKey:    pubKey,
+		CertChain: req.GetCertChain(),
+		Challenge: req.GetChallenge(),
+		Bond:      bond,
+	}
+	var res *sdk.TxResponse
+	if s.pool.Contains(creator) {
+		res, err = s.pool.BroadcastFrom(ctx, creator, msg)
+	} else {
+		res, err = s.pool.Broadcast(ctx, func(relayer sdk.AccAddress) []sdk.Msg {
+			exec := authz.NewMsgExec(relayer, []sdk.Msg{msg})
+			return []sdk.Msg{&exec}
+		})
+	}
+	if err != nil {
+		return nil, chainStatus(err, "registration failed")
+	}
+	return &apiv1.RegisterNodeResponse{
+		Success: true,
+		Message: fmt.Sprintf("registration submitted in tx %s", res.TxHash),
+		NodeId:  creator.String(),
+	}, nil
+}
+
+// teeTrustTier is the trust tier of the nodes registered through the
+// gateway, which are attested by a hardware keystore.
+const teeTrustTier = 1
+
+// registrationBond returns the bond the creator still has to post to hold the
+// minimum bond of teeTrustTier, zero when it already does.
+func (s *Server) registrationBond(ctx context.Context, creator string) (sdk.Coin, error) {
+	params, err := s.query.Params(ctx, &realitytypes.QueryParamsRequest{})
+	if err != nil {
+		return sdk.Coin{}, status.Errorf(codes.Unavailable, "failed to query params: %v", err)
+	}
+	minBond, ok := params.Params.MinBond(teeTrustTier)
+	if !ok {
+		return sdk.Coin{}, nil
+	}
+	posted, err := s.query.Bond(ctx, &realitytypes.QueryBondRequest{Owner: creator})
+	if err != nil {
+		return sdk.Coin{}, status.Errorf(codes.Unavailable, "failed to query bond: %v", err)
+	}
+	if posted.Bond.Amount.IsGTE(minBond) {
+		return sdk.Coin{}, nil
+	}
+	return minBond.Sub(posted.Bond.Amount), nil
+}
+
+// SubmitData verifies a device reading against the registered device key and
+// queues it as a MsgCreateClaim to be relayed in the next batch transaction.
+// The returned submission id is used to follow it with GetSubmissionStatus.
+func (s *Server) SubmitData(ctx context.Context, req *apiv1.SubmitDataRequest) (*apiv1.SubmitDataResponse, error) {
+	id, err := s.submit(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.SubmitDataResponse{Success: true, SubmissionId: id}, nil
+}
+
+// submit verifies a reading and queues its claim, passing watch to the
+// batcher.
+func (s *Server) submit(ctx context.Context, req *apiv1.SubmitDataRequest, watch func(Submission)) (string, error) {
+	if _, err := sdk.AccAddressFromBech32(req.GetNodeId()); err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid node id: %v", err)
+	}
+	if req.GetPayload() == "" || req.GetSignature() == "" {
+		return "", status.Error(codes.InvalidArgument, "payload and signature are required")
+	}
+
+	node, err := s.query.GetNodeInfo(ctx, &realitytypes.QueryGetNodeInfoRequest{Creator: req.GetNodeId()})
+	if err != nil {
+		return "", status.Errorf(codes.NotFound, "node %s is not registered", req.GetNodeId())
+	}
+	if !realitykeeper.VerifyDeviceSignature(node.NodeInfo.PubKey, []byte(req.GetPayload()), req.GetSignature()) {
+		return "", status.Error(codes.PermissionDenied, "signature does not match the device key")
+	}
+
+	// Creator는 배치 전송 시 선택된 릴레이어 키로 채워짐
+	msg := &realitytypes.MsgCreateClaim{
+		NodeId:        req.GetNodeId(),
+		Payload:       req.GetPayload(),
+		DataSignature: req.GetSignature(),
+		Cert:          req.GetCert(),
+		SensorHash:    payloadHash(req.GetPayload()),
+		Timestamp:     s.now().Unix(),
+	}
+	var ok bool
+	if msg.Latitude, msg.Longitude, ok = parseLocation(req.GetPayload()); !ok {
+		return "", status.Error(codes.InvalidArgument, "payload has no valid location")
+	}
+	msg.GnssHash = payloadHash(fmt.Sprintf("%d,%d", msg.Latitude, msg.Longitude))
+
+	id, err := s.batcher.Enqueue(msg, watch)
+	if errors.Is(err, ErrQueueFull) {
+		return "", status.Error(codes.ResourceExhausted, err.Error())
+	} else if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to queue claim: %v", err)
+	}
+	return id, nil
+}
+
+// GetSubmissionStatus reports the state of a submitted claim. Once the batch
+// transaction of a claim is broadcast, the transaction is looked up to tell
+// whether it was committed.
+func (s *Server) GetSubmissionStatus(_ context.Context, req *apiv1.GetSubmissionStatusRequest) (*apiv1.GetSubmissionStatusResponse, error) {
+	sub, ok := s.batcher.Submission(req.GetSubmissionId())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown submission %s", req.GetSubmissionId())
+	}
+	// 블록에 포함되기 전에는 조회되지 않으므로 전송 상태 유지
+	if sub.Status == apiv1.SubmissionStatus_SUBMISSION_STATUS_BROADCAST && commitTx(s.clientCtx, s.batcher, sub.TxHash) {
+		sub, _ = s.batcher.Submission(sub.ID)
+	}
+	return &apiv1.GetSubmissionStatusResponse{
+		SubmissionId: sub.ID,
+		Status:       sub.Status,
+		TxHash:       sub.TxHash,
+		Height:       sub.Height,
+		Error:        sub.Error,
+	}, nil
+}
+
+// chainStatus converts the error of a relayed transaction to a gRPC status
+// with the code registered for the x/reality error causing it, or Aborted
+// when the transaction failed for another reason.
+func chainStatus(err error, msg string) error {
+	code := codes.Aborted
+	if moduleErr, ok := moduleError(err); ok {
+		code = moduleErr.GRPCStatus().Code()
+	}
+	return status.Errorf(code, "%s: %v", msg, err)
+}
@@ -0,0 +1,452 @@
+package gateway_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	mrand "math/rand"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"contactical/gateway"
+	apiv1 "contactical/proto/contactical/contactical/api/v1"
+	"contactical/testutil/network"
+	realitysimulation "contactical/x/reality/simulation"
+	realitytypes "contactical/x/reality/types"
+)
+
+// startGateway starts a network with default params and a gateway relaying
+// through the key of its validator and returns the network, a client of the
+// gateway and the registry of the relayer metrics. Additional relayer keys are created and
+// topped up from the validator account.
+func startGateway(t *testing.T, batchCfg gateway.BatchConfig, relayerKeys ...string) (*network.Network, apiv1.ContacticalServiceClient, *prometheus.Registry) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	chain := network.New(t, cfg)
+	val := chain.Validators[0]
+
+	clientCtx := val.ClientCtx.WithFromName(val.Moniker).WithFromAddress(val.Address)
+	txf := tx.Factory{}.
+		WithGasAdjustment(1.5).
+		WithGasPrices(cfg.MinGasPrices).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+	treasury, err := gateway.NewRelayer(clientCtx, txf)
+	require.NoError(t, err)
+	relayers := []*gateway.Relayer{treasury}
+	for _, name := range relayerKeys {
+		_, _, err := clientCtx.Keyring.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		relayer, err := gateway.NewRelayerFromKey(clientCtx, txf, name)
+		require.NoError(t, err)
+		relayers = append(relayers, relayer)
+	}
+
+	registry := prometheus.NewRegistry()
+	pool, err := gateway.NewRelayerPool(clientCtx, relayers, gateway.StrategyRoundRobin, &gateway.TopUp{
+		Treasury:  treasury,
+		Threshold: sdk.NewInt64Coin(cfg.BondDenom, 1_000_000),
+		Amount:    sdk.NewInt64Coin(cfg.BondDenom, 10_000_000),
+	}, gateway.NewPoolMetrics(registry))
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, pool.TopUp(ctx))
+	if len(relayerKeys) > 0 {
+		require.NoError(t, chain.WaitForNextBlock())
+	}
+
+	batcher := gateway.NewBatcher(pool, batchCfg)
+	go batcher.Run(ctx)
+	go func() {
+		_ = gateway.NewClaimWatcher(clientCtx, batcher, val.RPCAddress, time.Second).Run(ctx)
+	}()
+
+	challenges := gateway.NewChallenges(gateway.NewMemChallengeStore(), gateway.DefaultChallengeTTL)
+	roots := gateway.NewAttestationRoots([]*x509.Certificate{simulatedRoot(t, 1)}, nil)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	apiv1.RegisterContacticalServiceServer(srv, gateway.NewServer(clientCtx, pool, challenges, roots, batcher))
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return chain, apiv1.NewContacticalServiceClient(conn), registry
+}
+
+func TestGatewayRelaysRegistrationAndClaims(t *testing.T) {
+	// 세 건이 모이면 바로 하나의 트랜잭션으로 전송
+	batchCfg := gateway.DefaultBatchConfig()
+	batchCfg.MaxMsgs = 3
+	batchCfg.FlushInterval = time.Minute
+	chain, gw, _ := startGateway(t, batchCfg)
+	val := chain.Validators[0]
+	ctx := context.Background()
+	query := realitytypes.NewQueryClient(val.ClientCtx)
+
+	device, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	attest := func(challenge string) []string {
+		raw, err := base64.StdEncoding.DecodeString(challenge)
+		require.NoError(t, err)
+		certChain, err := realitysimulation.AttestationCertChain(mrand.New(mrand.NewSource(1)), device, raw, time.Now())
+		require.NoError(t, err)
+		return certChain
+	}
+	register := func(challenge string, certChain []string) (*apiv1.RegisterNodeResponse, error) {
+		return gw.RegisterNode(ctx, &apiv1.RegisterNodeRequest{
+			CreatorAddress: val.Address.String(),
+			CertChain:      certChain,
+			Challenge:      challenge,
+		})
+	}
+	issue := func() string {
+		res, err := gw.GetChallenge(ctx, &apiv1.GetChallengeRequest{CreatorAddress: val.Address.String()})
+		require.NoError(t, err)
+		require.Greater(t, res.ExpiresAt, time.Now().Unix())
+		return res.Challenge
+	}
+
+	// challenges not issued by the gateway are rejected
+	unknown := base64.StdEncoding.EncodeToString([]byte("gateway-test-challenge-0123456789"))
+	_, err = register(unknown, attest(unknown))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// a chain attesting another challenge is rejected before broadcasting
+	_, err = register(issue(), attest(unknown))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// a chain signed by a root that is not pinned is rejected
+	untrusted := issue()
+	raw, err := base64.StdEncoding.DecodeString(untrusted)
+	require.NoError(t, err)
+	untrustedChain, err := realitysimulation.AttestationCertChain(mrand.New(mrand.NewSource(2)), device, raw, time.Now())
+	require.NoError(t, err)
+	_, err = register(untrusted, untrustedChain)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.ErrorContains(t, err, "pinned attestation root")
+
+	challenge := issue()
+	certChain := attest(challenge)
+	registered, err := register(challenge, certChain)
+	require.NoError(t, err)
+	require.True(t, registered.Success)
+	require.Equal(t, val.Address.String(), registered.NodeId)
+
+	// an issued challenge is redeemed only once
+	_, err = register(challenge, certChain)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	pubKey, err := realitysimulation.DevicePubKey(device)
+	require.NoError(t, err)
+	require.NoError(t, chain.RetryForBlocks(func() error {
+		res, err := query.GetNodeInfo(ctx, &realitytypes.QueryGetNodeInfoRequest{Creator: registered.NodeId})
+		if err != nil {
+			return err
+		}
+		require.Equal(t, pubKey, res.NodeInfo.PubKey)
+		require.EqualValues(t, 1, res.NodeInfo.TrustTier)
+		return nil
+	}, 5))
+
+	// the minimum bond of the TEE tier is posted with the registration
+	params, err := query.Params(ctx, &realitytypes.QueryParamsRequest{})
+	require.NoError(t, err)
+	minBond, ok := params.Params.MinBond(1)
+	require.True(t, ok)
+	bond, err := query.Bond(ctx, &realitytypes.QueryBondRequest{Owner: registered.NodeId})
+	require.NoError(t, err)
+	require.Equal(t, minBond, bond.Bond.Amount)
+
+	payload := "lat: 37.5665, lon: 126.978 #SOS"
+	signature, err := realitysimulation.SignPayload(device, payload)
+	require.NoError(t, err)
+
+	// readings not signed by the registered device key are rejected
+	forged, err := realitysimulation.SignPayload(mustKey(t), payload)
+	require.NoError(t, err)
+	_, err = gw.SubmitData(ctx, &apiv1.SubmitDataRequest{NodeId: registered.NodeId, Payload: payload, Signature: forged})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// readings without a valid location are not relayed as claims at 0,0
+	for _, reading := range []string{"#SOS", "lat: 91.0, lon: 126.978", "lat: 37.5665, lon: -180.5"} {
+		signature, err := realitysimulation.SignPayload(device, reading)
+		require.NoError(t, err)
+		_, err = gw.SubmitData(ctx, &apiv1.SubmitDataRequest{NodeId: registered.NodeId, Payload: reading, Signature: signature})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), reading)
+	}
+
+	// readings are relayed together in one batch transaction
+	var submissions []string
+	for _, reading := range []string{payload, "lat: 37.5666, lon: 126.979", "lat: 37.5667, lon: 126.980"} {
+		signature, err := realitysimulation.SignPayload(device, reading)
+		require.NoError(t, err)
+		submitted, err := gw.SubmitData(ctx, &apiv1.SubmitDataRequest{NodeId: registered.NodeId, Payload: reading, Signature: signature})
+		require.NoError(t, err)
+		require.True(t, submitted.Success)
+		require.NotEmpty(t, submitted.SubmissionId)
+		submissions = append(submissions, submitted.SubmissionId)
+	}
+
+	var txHash string
+	for _, id := range submissions {
+		require.NoError(t, chain.RetryForBlocks(func() error {
+			res, err := gw.GetSubmissionStatus(ctx, &apiv1.GetSubmissionStatusRequest{SubmissionId: id})
+			if err != nil {
+				return err
+			}
+			if res.Status != apiv1.SubmissionStatus_SUBMISSION_STATUS_COMMITTED {
+				return fmt.Errorf("submission %s is %s", id, res.Status)
+			}
+			require.NotZero(t, res.Height)
+			if txHash == "" {
+				txHash = res.TxHash
+			}
+			require.Equal(t, txHash, res.TxHash)
+			return nil
+		}, 5))
+	}
+
+	res, err := query.ListClaim(ctx, &realitytypes.QueryAllClaimRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Claim, 3)
+	claim := res.Claim[0]
+	require.Equal(t, registered.NodeId, claim.Creator)
+	require.Equal(t, val.Address.String(), claim.Relayer)
+	require.EqualValues(t, 37_566_500, claim.Latitude)
+	require.EqualValues(t, 126_978_000, claim.Longitude)
+	require.EqualValues(t, 2, claim.RewardMultiplier)
+
+	_, err = gw.GetSubmissionStatus(ctx, &apiv1.GetSubmissionStatusRequest{SubmissionId: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = gw.SubmitData(ctx, &apiv1.SubmitDataRequest{NodeId: sdk.AccAddress("unregistered-node___").String(), Payload: payload, Signature: signature})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func mustKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+// simulatedRoot returns the root of the simulated attestation chains generated
+// from seed.
+func simulatedRoot(t *testing.T, seed int64) *x509.Certificate {
+	t.Helper()
+	certChain, err := realitysimulation.AttestationCertChain(mrand.New(mrand.NewSource(seed)), mustKey(t), []byte("root"), time.Now())
+	require.NoError(t, err)
+	der, err := base64.StdEncoding.DecodeString(certChain[len(certChain)-1])
+	require.NoError(t, err)
+	root, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return root
+}
+
+// registerDevice registers a new device of the validator through the gateway
+// and waits for the registration to be committed.
+func registerDevice(t *testing.T, chain *network.Network, gw apiv1.ContacticalServiceClient) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+	ctx := context.Background()
+	val := chain.Validators[0]
+
+	challenge, err := gw.GetChallenge(ctx, &apiv1.GetChallengeRequest{CreatorAddress: val.Address.String()})
+	require.NoError(t, err)
+	raw, err := base64.StdEncoding.DecodeString(challenge.Challenge)
+	require.NoError(t, err)
+	device := mustKey(t)
+	certChain, err := realitysimulation.AttestationCertChain(mrand.New(mrand.NewSource(1)), device, raw, time.Now())
+	require.NoError(t, err)
+
+	registered, err := gw.RegisterNode(ctx, &apiv1.RegisterNodeRequest{
+		CreatorAddress: val.Address.String(),
+		CertChain:      certChain,
+		Challenge:      challenge.Challenge,
+	})
+	require.NoError(t, err)
+	query := realitytypes.NewQueryClient(val.ClientCtx)
+	require.NoError(t, chain.RetryForBlocks(func() error {
+		_, err := query.GetNodeInfo(ctx, &realitytypes.QueryGetNodeInfoRequest{Creator: registered.NodeId})
+		return err
+	}, 5))
+	return device, registered.NodeId
+}
+
+// reading returns a stream request of a reading signed by device.
+func reading(t *testing.T, requestID string, device *ecdsa.PrivateKey, nodeID, payload string) *apiv1.StreamSubmitDataRequest {
+	t.Helper()
+	signature, err := realitysimulation.SignPayload(device, payload)
+	require.NoError(t, err)
+	return &apiv1.StreamSubmitDataRequest{
+		RequestId: requestID,
+		Reading:   &apiv1.SubmitDataRequest{NodeId: nodeID, Payload: payload, Signature: signature},
+	}
+}
+
+func TestGatewayStreamsClaimResults(t *testing.T) {
+	batchCfg := gateway.DefaultBatchConfig()
+	batchCfg.FlushInterval = 500 * time.Millisecond
+	chain, gw, _ := startGateway(t, batchCfg)
+	device, nodeID := registerDevice(t, chain, gw)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	stream, err := gw.StreamSubmitData(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(reading(t, "sos", device, nodeID, "lat: 37.5665, lon: 126.978 #SOS")))
+	require.NoError(t, stream.Send(reading(t, "plain", device, nodeID, "lat: 37.5666, lon: 126.979")))
+	forged := reading(t, "forged", mustKey(t), nodeID, "lat: 37.5667, lon: 126.980")
+	require.NoError(t, stream.Send(forged))
+	require.NoError(t, stream.CloseSend())
+
+	// 클라이언트가 전송을 마쳐도 모든 제출의 최종 결과를 받은 뒤 스트림이 끝남
+	final := map[string]*apiv1.StreamSubmitDataResponse{}
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		require.NotEmpty(t, res.ResumeToken)
+		switch res.Status {
+		case apiv1.SubmissionStatus_SUBMISSION_STATUS_COMMITTED, apiv1.SubmissionStatus_SUBMISSION_STATUS_FAILED,
+			apiv1.SubmissionStatus_SUBMISSION_STATUS_REJECTED:
+			final[res.RequestId] = res
+		}
+	}
+	require.Len(t, final, 3)
+	require.Equal(t, apiv1.SubmissionStatus_SUBMISSION_STATUS_REJECTED, final["forged"].Status)
+	require.Contains(t, final["forged"].Error, "signature")
+	for requestID, multiplier := range map[string]int64{"sos": 2, "plain": 1} {
+		res := final[requestID]
+		require.Equal(t, apiv1.SubmissionStatus_SUBMISSION_STATUS_COMMITTED, res.Status, res.Error)
+		require.NotEmpty(t, res.TxHash)
+		require.NotZero(t, res.Height)
+		require.Positive(t, res.TrustScore)
+		require.Positive(t, res.RewardPoints)
+		require.Equal(t, multiplier, res.RewardMultiplier)
+	}
+	require.NotEqual(t, final["sos"].ClaimId, final["plain"].ClaimId)
+
+	// a client reconnecting with a resume token receives the results that
+	// followed it
+	streamCtx, drop := context.WithCancel(ctx)
+	stream, err = gw.StreamSubmitData(streamCtx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(reading(t, "resumed", device, nodeID, "lat: 37.5668, lon: 126.981")))
+	accepted, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, apiv1.SubmissionStatus_SUBMISSION_STATUS_PENDING, accepted.Status)
+	drop()
+
+	var resumed *apiv1.StreamSubmitDataResponse
+	require.NoError(t, chain.RetryForBlocks(func() error {
+		stream, err = gw.StreamSubmitData(ctx)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&apiv1.StreamSubmitDataRequest{ResumeToken: accepted.ResumeToken}); err != nil {
+			return err
+		}
+		// 이전 스트림이 아직 닫히지 않았으면 세션이 붙어 있어 거부됨
+		resumed, err = stream.Recv()
+		return err
+	}, 5))
+	require.NoError(t, stream.CloseSend())
+	for resumed.Status != apiv1.SubmissionStatus_SUBMISSION_STATUS_COMMITTED {
+		require.Equal(t, "resumed", resumed.RequestId)
+		require.Equal(t, accepted.SubmissionId, resumed.SubmissionId)
+		resumed, err = stream.Recv()
+		require.NoError(t, err)
+	}
+	require.Positive(t, resumed.ClaimId)
+	_, err = stream.Recv()
+	require.ErrorIs(t, err, io.EOF)
+
+	stream, err = gw.StreamSubmitData(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&apiv1.StreamSubmitDataRequest{ResumeToken: "unknown:0"}))
+	_, err = stream.Recv()
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGatewayRelaysThroughKeyPool(t *testing.T) {
+	// 제출마다 별도 트랜잭션으로 전송해 여러 키로 나눠 보냄
+	batchCfg := gateway.DefaultBatchConfig()
+	batchCfg.MaxMsgs = 1
+	batchCfg.FlushInterval = time.Minute
+	chain, gw, registry := startGateway(t, batchCfg, "relayer-1", "relayer-2")
+	device, nodeID := registerDevice(t, chain, gw)
+	ctx := context.Background()
+
+	stream, err := gw.StreamSubmitData(ctx)
+	require.NoError(t, err)
+	for i := range 6 {
+		payload := fmt.Sprintf("lat: 37.56%d, lon: 126.97%d", i, i)
+		require.NoError(t, stream.Send(reading(t, strconv.Itoa(i), device, nodeID, payload)))
+	}
+	require.NoError(t, stream.CloseSend())
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		require.NotEqual(t, apiv1.SubmissionStatus_SUBMISSION_STATUS_FAILED, res.Status, res.Error)
+	}
+
+	query := realitytypes.NewQueryClient(chain.Validators[0].ClientCtx)
+	claims, err := query.ListClaim(ctx, &realitytypes.QueryAllClaimRequest{})
+	require.NoError(t, err)
+	require.Len(t, claims.Claim, 6)
+	relayers := map[string]int{}
+	for _, claim := range claims.Claim {
+		relayers[claim.Relayer]++
+	}
+	require.Len(t, relayers, 3)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	broadcasts, topUps := map[string]float64{}, map[string]float64{}
+	for _, family := range families {
+		for _, metric := range family.Metric {
+			key := metric.Label[0].GetValue()
+			switch family.GetName() {
+			case "contactical_gateway_relayer_broadcasts_total":
+				if metric.Label[1].GetValue() == "success" {
+					broadcasts[key] += metric.Counter.GetValue()
+				}
+			case "contactical_gateway_relayer_top_ups_total":
+				topUps[key] += metric.Counter.GetValue()
+			}
+		}
+	}
+	require.Equal(t, map[string]float64{"relayer-1": 1, "relayer-2": 1}, topUps)
+	require.Len(t, broadcasts, 3)
+	for key, n := range broadcasts {
+		require.GreaterOrEqual(t, n, float64(2), key)
+	}
+}
@@ -0,0 +1,291 @@
+package gateway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "contactical/proto/contactical/contactical/api/v1"
+)
+
+const (
+	// streamMaxInFlight is how many submissions of a stream can wait for
+	// their final result before the gateway stops reading new readings.
+	streamMaxInFlight = 256
+	// streamReplay is how many delivered results are kept to be replayed to
+	// a client resuming its stream.
+	streamReplay = 1024
+	// streamSessionTTL is how long a detached stream can be resumed.
+	streamSessionTTL = 10 * time.Minute
+)
+
+// streamResult is a result queued for a stream. Final results end a
+// submission and free its in-flight slot once delivered.
+type streamResult struct {
+	resp     *apiv1.StreamSubmitDataResponse
+	final    bool
+	released bool
+}
+
+// streamSession holds the results of a StreamSubmitData stream so that they
+// survive reconnections. Results are numbered by sequence and the resume
+// token of a result is <session id>:<sequence>.
+type streamSession struct {
+	id    string
+	slots chan struct{}
+	wake  chan struct{}
+
+	mu         sync.Mutex
+	results    []*streamResult
+	first      uint64 // sequence of results[0]
+	next       uint64 // sequence of the next result to deliver
+	attached   bool
+	detachedAt time.Time
+}
+
+// push queues a result and wakes the sender.
+func (s *streamSession) push(resp *apiv1.StreamSubmitDataResponse, final bool) {
+	s.mu.Lock()
+	seq := s.first + uint64(len(s.results))
+	resp.ResumeToken = fmt.Sprintf("%s:%d", s.id, seq)
+	s.results = append(s.results, &streamResult{resp: resp, final: final})
+	// 전달된 결과만 재전송 버퍼 한도를 넘으면 버림
+	for len(s.results) > streamReplay && s.first < s.next {
+		s.results = s.results[1:]
+		s.first++
+	}
+	s.mu.Unlock()
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// pending returns the next result to deliver, if any.
+func (s *streamSession) pending() (*streamResult, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.next >= s.first+uint64(len(s.results)) {
+		return nil, false
+	}
+	result := s.results[s.next-s.first]
+	s.next++
+	return result, true
+}
+
+// delivered frees the in-flight slot of a delivered final result.
+func (s *streamSession) delivered(result *streamResult) {
+	s.mu.Lock()
+	release := result.final && !result.released
+	result.released = true
+	s.mu.Unlock()
+	if release {
+		<-s.slots
+	}
+}
+
+// streamSessions tracks the sessions of the streams of a server.
+type streamSessions struct {
+	mu       sync.Mutex
+	sessions map[string]*streamSession
+	now      func() time.Time
+}
+
+func newStreamSessions() *streamSessions {
+	return &streamSessions{sessions: make(map[string]*streamSession), now: time.Now}
+}
+
+// attach returns a new session, or the session of a resume token rewound to
+// the result following the token.
+func (r *streamSessions) attach(token string) (*streamSession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, sess := range r.sessions {
+		sess.mu.Lock()
+		expired := !sess.attached && r.now().Sub(sess.detachedAt) > streamSessionTTL
+		sess.mu.Unlock()
+		if expired {
+			delete(r.sessions, id)
+		}
+	}
+
+	if token == "" {
+		id := make([]byte, 16)
+		if _, err := rand.Read(id); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create stream session: %v", err)
+		}
+		sess := &streamSession{
+			id:       hex.EncodeToString(id),
+			slots:    make(chan struct{}, streamMaxInFlight),
+			wake:     make(chan struct{}, 1),
+			attached: true,
+		}
+		r.sessions[sess.id] = sess
+		return sess, nil
+	}
+
+	id, seqStr, _ := strings.Cut(token, ":")
+	seq, err := strconv.ParseUint(seqStr, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid resume token %q", token)
+	}
+	sess, ok := r.sessions[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "unknown or expired resume token")
+	}
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	switch {
+	case sess.attached:
+		return nil, status.Error(codes.FailedPrecondition, "stream session is already attached")
+	case seq+1 < sess.first || seq >= sess.first+uint64(len(sess.results)):
+		return nil, status.Error(codes.OutOfRange, "results after the resume token are no longer available")
+	}
+	sess.next = seq + 1
+	sess.attached = true
+	return sess, nil
+}
+
+// detach marks a session as resumable until it expires.
+func (r *streamSessions) detach(sess *streamSession) {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	sess.attached = false
+	sess.detachedAt = r.now()
+}
+
+// StreamSubmitData accepts readings over one long-lived stream and pushes a
+// result whenever the state of one of its submissions changes: when it is
+// accepted or rejected, broadcast, and committed with the trust score and
+// reward points computed by the chain. Readings are not read while too many
+// submissions wait for their result. A client reconnecting sends the resume
+// token of the last result it received in its first message to receive the
+// following results.
+func (s *Server) StreamSubmitData(stream apiv1.ContacticalService_StreamSubmitDataServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+	sess, err := s.sessions.attach(req.GetResumeToken())
+	if err != nil {
+		return err
+	}
+	defer s.sessions.detach(sess)
+
+	// 세션을 떼기 전에 결과 전송 고루틴이 끝나기를 기다림
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	closed := make(chan struct{})
+	sent := make(chan error, 1)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sent <- s.sendResults(ctx, stream, sess, closed)
+	}()
+
+	for {
+		// 처리 중인 제출이 많으면 다음 요청을 읽지 않아 gRPC 흐름 제어로 클라이언트를 늦춤
+		select {
+		case sess.slots <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-sent:
+			return err
+		}
+		if req.GetReading() != nil {
+			s.streamReading(ctx, sess, req)
+		} else {
+			<-sess.slots
+		}
+
+		req, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			// 남은 결과를 모두 전달한 뒤 스트림 종료
+			close(closed)
+			return <-sent
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// streamReading submits the reading of a stream request, reporting every
+// state of the submission to the session.
+func (s *Server) streamReading(ctx context.Context, sess *streamSession, req *apiv1.StreamSubmitDataRequest) {
+	requestID := req.GetRequestId()
+	_, err := s.submit(ctx, req.GetReading(), func(sub Submission) {
+		sess.push(streamResponse(requestID, sub), sub.final())
+	})
+	if err != nil {
+		sess.push(&apiv1.StreamSubmitDataResponse{
+			RequestId: requestID,
+			Status:    apiv1.SubmissionStatus_SUBMISSION_STATUS_REJECTED,
+			Error:     status.Convert(err).Message(),
+		}, true)
+	}
+}
+
+// sendResults delivers the results of a session until ctx is done, or until
+// closed is closed and no submission is in flight anymore.
+func (s *Server) sendResults(ctx context.Context, stream apiv1.ContacticalService_StreamSubmitDataServer, sess *streamSession, closed <-chan struct{}) error {
+	finishing := false
+	for {
+		for {
+			result, ok := sess.pending()
+			if !ok {
+				break
+			}
+			if err := stream.Send(result.resp); err != nil {
+				return err
+			}
+			sess.delivered(result)
+		}
+		if finishing && len(sess.slots) == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-sess.wake:
+		case <-closed:
+			// 클라이언트가 전송을 마치면 진행 중인 제출의 결과까지만 전달
+			finishing = true
+			closed = nil
+		}
+	}
+}
+
+func streamResponse(requestID string, sub Submission) *apiv1.StreamSubmitDataResponse {
+	resp := &apiv1.StreamSubmitDataResponse{
+		RequestId:    requestID,
+		SubmissionId: sub.ID,
+		Status:       sub.Status,
+		Error:        sub.Error,
+		TxHash:       sub.TxHash,
+		Height:       sub.Height,
+	}
+	if sub.Claim != nil {
+		resp.ClaimId = sub.Claim.ClaimID
+		resp.TrustScore = sub.Claim.TrustScore
+		resp.RewardMultiplier = sub.Claim.RewardMultiplier
+		resp.RewardPoints = sub.Claim.RewardPoints
+	}
+	return resp
+}
@@ -0,0 +1,340 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultClaimLimit is the number of claims returned when no limit is
+	// requested.
+	DefaultClaimLimit = 1000
+	// MaxClaimLimit bounds the limit of a claims request.
+	MaxClaimLimit = 50_000
+	// maxTileClaims bounds the claims of a tile deeper than ClusterMaxZoom
+	// encoded one feature per claim. Denser tiles are clustered.
+	maxTileClaims = 200_000
+)
+
+// API serves the index over HTTP:
+//
+//	GET /status                 height of the last indexed block
+//	GET /claims                 claims as a GeoJSON FeatureCollection
+//	GET /heatmap/{z}/{x}/{y}    claims of a tile binned into heatmap cells
+//	GET /tiles/{z}/{x}/{y}.mvt  claims of a tile as a Mapbox Vector Tile
+//	GET /nodes                  nodes with the statistics of their claims
+//	GET /nodes/{id}             statistics and paid rewards of one node
+//
+// Claims, heatmaps and vector tiles are filtered with the query parameters bbox
+// (min_lon,min_lat,max_lon,max_lat in degrees), from and to (RFC 3339),
+// since (a duration before now), min_score, max_score and node.
+type API struct {
+	store *Store
+	now   func() time.Time
+	mux   *http.ServeMux
+	tiles *tileCache
+}
+
+// NewAPI returns the HTTP API of store.
+func NewAPI(store *Store) *API {
+	a := &API{store: store, now: time.Now, mux: http.NewServeMux(), tiles: newTileCache(DefaultTileCacheSize)}
+	a.mux.HandleFunc("GET /status", a.status)
+	a.mux.HandleFunc("GET /claims", a.claims)
+	a.mux.HandleFunc("GET /heatmap/{z}/{x}/{y}", a.heatmap)
+	a.mux.HandleFunc("GET /tiles/{z}/{x}/{y}", a.vectorTile)
+	a.mux.HandleFunc("GET /nodes", a.nodes)
+	a.mux.HandleFunc("GET /nodes/{id}", a.node)
+	return a
+}
+
+// ServeHTTP implements http.Handler. Any origin may read the API, so that
+// the dashboard can be opened from a file or another host.
+func (a *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	a.mux.ServeHTTP(w, r)
+}
+
+func (a *API) status(w http.ResponseWriter, r *http.Request) {
+	height, err := a.store.Height(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, map[string]int64{"height": height})
+}
+
+func (a *API) claims(w http.ResponseWriter, r *http.Request) {
+	filter, err := a.claimFilter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	claims, err := a.store.Claims(r.Context(), filter)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	features := make([]Feature, len(claims))
+	for i, c := range claims {
+		features[i] = claimFeature(c)
+	}
+	writeJSON(w, newFeatureCollection(features))
+}
+
+func (a *API) heatmap(w http.ResponseWriter, r *http.Request) {
+	tile, err := pathTile(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	filter, err := a.claimFilter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	cells, err := a.store.ClaimCells(r.Context(), filter, tile, heatmapGrid)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, heatmap(cells))
+}
+
+// vectorTile serves the claims of a tile as a Mapbox Vector Tile. Tiles are
+// cached until a claim is indexed inside them, except those filtered with
+// since whose window moves with time.
+func (a *API) vectorTile(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasSuffix(r.PathValue("y"), ".mvt") {
+		http.NotFound(w, r)
+		return
+	}
+	tile, err := pathTile(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	filter, err := a.claimFilter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	height, err := a.store.Height(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if err := a.tiles.advance(height, func(from, to int64) ([]Location, error) {
+		return a.store.ClaimLocations(r.Context(), from, to)
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	bbox := tile.BBox()
+	key := r.URL.Path + "?" + r.URL.RawQuery
+	cacheable := !r.URL.Query().Has("since")
+	var data []byte
+	var ok bool
+	if cacheable {
+		data, ok = a.tiles.get(height, key)
+	}
+	if !ok {
+		if data, err = a.encodeTile(r.Context(), tile, filter); err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		if cacheable {
+			a.tiles.add(height, key, bbox, data)
+		}
+	}
+	w.Header().Set("Content-Type", "application/vnd.mapbox-vector-tile")
+	_, _ = w.Write(data)
+}
+
+// encodeTile encodes the claims of a tile matching the filter. Tiles up to
+// ClusterMaxZoom, and deeper tiles holding more than maxTileClaims claims, are
+// clustered by the database.
+func (a *API) encodeTile(ctx context.Context, tile Tile, filter ClaimFilter) ([]byte, error) {
+	if tile.Z > ClusterMaxZoom {
+		bbox := tile.BBox()
+		filter.BBox = &bbox
+		filter.Limit = maxTileClaims + 1
+		claims, err := a.store.Claims(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		if len(claims) <= maxTileClaims {
+			return vectorTile(tile, claims), nil
+		}
+	}
+	cells, err := a.store.ClaimCells(ctx, filter, tile, clusterGrid)
+	if err != nil {
+		return nil, err
+	}
+	return clusterTile(tile, cells), nil
+}
+
+func (a *API) nodes(w http.ResponseWriter, r *http.Request) {
+	limit, err := queryLimit(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	nodes, err := a.store.Nodes(r.Context(), "", limit)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	out := make([]nodeJSON, len(nodes))
+	for i, n := range nodes {
+		out[i] = newNodeJSON(n)
+	}
+	writeJSON(w, map[string]any{"nodes": out})
+}
+
+func (a *API) node(w http.ResponseWriter, r *http.Request) {
+	nodes, err := a.store.Nodes(r.Context(), r.PathValue("id"), 0)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if len(nodes) == 0 {
+		writeError(w, http.StatusNotFound, fmt.Errorf("node %s is not indexed", r.PathValue("id")))
+		return
+	}
+	writeJSON(w, newNodeJSON(nodes[0]))
+}
+
+// nodeJSON is the JSON form of NodeStats.
+type nodeJSON struct {
+	NodeID        string  `json:"node_id"`
+	TrustTier     int32   `json:"trust_tier"`
+	SecurityLevel int32   `json:"security_level"`
+	Bond          string  `json:"bond"`
+	Registered    int64   `json:"registered_height"`
+	Claims        int64   `json:"claims"`
+	AvgScore      float64 `json:"avg_score"`
+	RewardPoints  int64   `json:"reward_points"`
+	LastClaim     string  `json:"last_claim,omitempty"`
+	Paid          string  `json:"paid"`
+}
+
+func newNodeJSON(n NodeStats) nodeJSON {
+	out := nodeJSON{
+		NodeID:        n.ID,
+		TrustTier:     n.TrustTier,
+		SecurityLevel: n.SecurityLevel,
+		Bond:          n.Bond,
+		Registered:    n.Height,
+		Claims:        n.Claims,
+		AvgScore:      n.AvgScore,
+		RewardPoints:  n.RewardPoints,
+		Paid:          n.Paid.String(),
+	}
+	if !n.LastClaim.IsZero() {
+		out.LastClaim = n.LastClaim.Format(time.RFC3339)
+	}
+	return out
+}
+
+// claimFilter parses the claim filter of a request.
+func (a *API) claimFilter(r *http.Request) (ClaimFilter, error) {
+	q := r.URL.Query()
+	var f ClaimFilter
+	var err error
+
+	if s := q.Get("bbox"); s != "" {
+		parts := strings.Split(s, ",")
+		if len(parts) != 4 {
+			return f, fmt.Errorf("bbox must be min_lon,min_lat,max_lon,max_lat: %q", s)
+		}
+		var v [4]float64
+		for i, p := range parts {
+			if v[i], err = strconv.ParseFloat(strings.TrimSpace(p), 64); err != nil {
+				return f, fmt.Errorf("invalid bbox %q: %w", s, err)
+			}
+		}
+		if v[0] > v[2] || v[1] > v[3] {
+			return f, fmt.Errorf("bbox minimum exceeds its maximum: %q", s)
+		}
+		f.BBox = &BBox{MinLon: microdegrees(v[0]), MinLat: microdegrees(v[1]), MaxLon: microdegrees(v[2]), MaxLat: microdegrees(v[3])}
+	}
+	if s := q.Get("from"); s != "" {
+		if f.From, err = time.Parse(time.RFC3339, s); err != nil {
+			return f, fmt.Errorf("invalid from: %w", err)
+		}
+	}
+	if s := q.Get("to"); s != "" {
+		if f.To, err = time.Parse(time.RFC3339, s); err != nil {
+			return f, fmt.Errorf("invalid to: %w", err)
+		}
+	}
+	if s := q.Get("since"); s != "" {
+		since, err := time.ParseDuration(s)
+		if err != nil {
+			return f, fmt.Errorf("invalid since: %w", err)
+		}
+		f.From = a.now().Add(-since)
+	}
+	for name, dst := range map[string]**int64{"min_score": &f.MinScore, "max_score": &f.MaxScore} {
+		if s := q.Get(name); s != "" {
+			v, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return f, fmt.Errorf("invalid %s: %w", name, err)
+			}
+			*dst = &v
+		}
+	}
+	f.NodeID = q.Get("node")
+	if f.Limit, err = queryLimit(r); err != nil {
+		return f, err
+	}
+	return f, nil
+}
+
+// queryLimit parses the limit of a request.
+func queryLimit(r *http.Request) (int, error) {
+	s := r.URL.Query().Get("limit")
+	if s == "" {
+		return DefaultClaimLimit, nil
+	}
+	limit, err := strconv.Atoi(s)
+	if err != nil || limit <= 0 {
+		return 0, fmt.Errorf("invalid limit %q", s)
+	}
+	return min(limit, MaxClaimLimit), nil
+}
+
+// pathTile parses the {z}/{x}/{y} tile of a request path. The y value may
+// carry a file extension.
+func pathTile(r *http.Request) (Tile, error) {
+	var t Tile
+	var err error
+	y, _, _ := strings.Cut(r.PathValue("y"), ".")
+	for _, v := range []struct {
+		name string
+		s    string
+		dst  *int
+	}{{"z", r.PathValue("z"), &t.Z}, {"x", r.PathValue("x"), &t.X}, {"y", y, &t.Y}} {
+		if *v.dst, err = strconv.Atoi(v.s); err != nil {
+			return t, fmt.Errorf("invalid tile %s %q", v.name, v.s)
+		}
+	}
+	return t, t.Validate()
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
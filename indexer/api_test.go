@@ -0,0 +1,260 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func getJSON(t *testing.T, api *API, path string, want int, out any) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	api.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	require.Equal(t, want, rec.Code, rec.Body.String())
+	require.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), out))
+}
+
+func claimIDs(fc FeatureCollection) []float64 {
+	ids := []float64{}
+	for _, f := range fc.Features {
+		ids = append(ids, f.Properties["claim_id"].(float64))
+	}
+	return ids
+}
+
+func TestAPIClaims(t *testing.T) {
+	api := NewAPI(indexedStore(t))
+	api.now = func() time.Time { return genesisTime.Add(3*time.Minute + 30*time.Second) }
+
+	var fc FeatureCollection
+	getJSON(t, api, "/claims", http.StatusOK, &fc)
+	require.Equal(t, "FeatureCollection", fc.Type)
+	require.Equal(t, []float64{5, 4, 2, 1}, claimIDs(fc))
+	f := fc.Features[2]
+	require.Equal(t, "Point", f.Geometry.Type)
+	require.Equal(t, [2]float64{126.979, 37.567}, f.Geometry.Coordinates)
+	require.Equal(t, "node-a", f.Properties["creator"])
+	require.Equal(t, float64(70), f.Properties["score"])
+	require.Equal(t, float64(140), f.Properties["reward_points"])
+	require.Equal(t, true, f.Properties["is_emergency"])
+	require.Equal(t, "2026-03-01T12:02:00Z", f.Properties["time"])
+
+	for path, want := range map[string][]float64{
+		// 서울 도심만 포함하는 영역
+		"/claims?bbox=126.9,37.5,127.1,37.6":                        {5, 2, 1},
+		"/claims?min_score=50&max_score=80":                         {5, 2},
+		"/claims?node=node-b":                                       {4},
+		"/claims?since=1m":                                          {5, 4},
+		"/claims?from=2026-03-01T12:00:00Z&to=2026-03-01T12:03:00Z": {2, 1},
+		"/claims?limit=1":                                           {5},
+		"/claims?node=unknown":                                      {},
+	} {
+		fc = FeatureCollection{}
+		getJSON(t, api, path, http.StatusOK, &fc)
+		require.Equal(t, want, claimIDs(fc), path)
+	}
+
+	for _, path := range []string{
+		"/claims?bbox=1,2,3",
+		"/claims?bbox=127,37,126,38",
+		"/claims?from=yesterday",
+		"/claims?min_score=high",
+		"/claims?limit=0",
+	} {
+		var body map[string]string
+		getJSON(t, api, path, http.StatusBadRequest, &body)
+		require.NotEmpty(t, body["error"], path)
+	}
+}
+
+func TestAPIHeatmap(t *testing.T) {
+	api := NewAPI(indexedStore(t))
+
+	// 한반도를 덮는 줌 4 타일에서 서울의 클레임 세 개는 같은 셀로 묶임
+	korea := "/heatmap/4/13/6"
+	var fc FeatureCollection
+	getJSON(t, api, korea, http.StatusOK, &fc)
+	require.Len(t, fc.Features, 2)
+	var counts []float64
+	for _, f := range fc.Features {
+		counts = append(counts, f.Properties["count"].(float64))
+	}
+	require.ElementsMatch(t, []float64{3, 1}, counts)
+	for _, f := range fc.Features {
+		if f.Properties["count"].(float64) == 3 {
+			require.Equal(t, float64(215), f.Properties["weight"])
+			require.Equal(t, 71.67, f.Properties["avg_score"])
+			require.Equal(t, float64(1), f.Properties["emergencies"])
+		}
+	}
+
+	// 부산을 포함하지 않는 서울 주변 타일
+	px, py := Tile{Z: 10}.Project(126.978, 37.5665)
+	fc = FeatureCollection{}
+	getJSON(t, api, fmt.Sprintf("/heatmap/10/%d/%d", int(px), int(py)), http.StatusOK, &fc)
+	total := 0.0
+	for _, f := range fc.Features {
+		total += f.Properties["count"].(float64)
+	}
+	require.Equal(t, float64(3), total)
+
+	fc = FeatureCollection{}
+	getJSON(t, api, korea+"?min_score=80", http.StatusOK, &fc)
+	require.Len(t, fc.Features, 1)
+
+	fc = FeatureCollection{}
+	getJSON(t, api, "/heatmap/0/0/0", http.StatusOK, &fc)
+	require.Len(t, fc.Features, 1)
+	require.Equal(t, float64(4), fc.Features[0].Properties["count"])
+
+	var body map[string]string
+	getJSON(t, api, "/heatmap/1/2/0", http.StatusBadRequest, &body)
+	getJSON(t, api, "/heatmap/23/0/0", http.StatusBadRequest, &body)
+}
+
+func TestAPINodes(t *testing.T) {
+	api := NewAPI(indexedStore(t))
+
+	var list struct {
+		Nodes []nodeJSON `json:"nodes"`
+	}
+	getJSON(t, api, "/nodes", http.StatusOK, &list)
+	require.Len(t, list.Nodes, 3)
+	require.Equal(t, "node-a", list.Nodes[0].NodeID)
+
+	var node nodeJSON
+	getJSON(t, api, "/nodes/node-a", http.StatusOK, &node)
+	require.Equal(t, nodeJSON{
+		NodeID:        "node-a",
+		TrustTier:     2,
+		SecurityLevel: 3,
+		Bond:          "100stake",
+		Registered:    1,
+		Claims:        2,
+		AvgScore:      80,
+		RewardPoints:  230,
+		LastClaim:     "2026-03-01T12:02:00Z",
+		Paid:          "120reward",
+	}, node)
+
+	var body map[string]string
+	getJSON(t, api, "/nodes/unknown", http.StatusNotFound, &body)
+
+	var status map[string]int64
+	getJSON(t, api, "/status", http.StatusOK, &status)
+	require.Equal(t, int64(3), status["height"])
+}
+
+func getTile(t *testing.T, api *API, path string) []byte {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	api.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, "application/vnd.mapbox-vector-tile", rec.Header().Get("Content-Type"))
+	return rec.Body.Bytes()
+}
+
+func TestAPIVectorTiles(t *testing.T) {
+	ctx := context.Background()
+	store := indexedStore(t)
+	api := NewAPI(store)
+
+	// 서울의 클레임 세 개는 클러스터로, 부산의 클레임은 점으로 인코딩
+	const korea = "/tiles/4/13/6.mvt"
+	data := getTile(t, api, korea)
+	features := decodeTile(t, data)
+	require.Len(t, features, 2)
+	require.Equal(t, uint64(3), features[0].properties["point_count"])
+	require.Equal(t, uint64(4), features[1].id)
+	require.Len(t, api.tiles.entries, 1)
+
+	// 캐시된 타일은 저장소를 조회하지 않고 그대로 반환
+	api.tiles.entries[korea+"?"].Value.(*tileCacheEntry).data = []byte("cached")
+	require.Equal(t, []byte("cached"), getTile(t, api, korea))
+
+	// 필터마다 따로 캐시하고, since 필터는 캐시하지 않음
+	features = decodeTile(t, getTile(t, api, korea+"?min_score=80"))
+	require.Len(t, features, 1)
+	require.Equal(t, uint64(1), features[0].id)
+	getTile(t, api, korea+"?since=1h")
+	require.Len(t, api.tiles.entries, 2)
+	const pacific = "/tiles/4/0/7.mvt"
+	require.Empty(t, decodeTile(t, getTile(t, api, pacific)))
+	api.tiles.entries[pacific+"?"].Value.(*tileCacheEntry).data = []byte("cached")
+
+	// 새 블록의 클레임이 들어간 타일만 캐시에서 제거
+	require.NoError(t, store.Apply(ctx, Block{
+		Height: 4,
+		Time:   genesisTime.Add(4 * time.Minute),
+		Claims: []Claim{{ID: 6, NodeID: "node-b", Latitude: 35_180_000, Longitude: 129_076_000, TrustScore: 60, RewardMultiplier: 1}},
+	}))
+	features = decodeTile(t, getTile(t, api, korea))
+	require.Len(t, features, 2)
+	require.Equal(t, uint64(2), features[1].properties["point_count"])
+	require.Len(t, api.tiles.entries, 2)
+	require.Equal(t, []byte("cached"), getTile(t, api, pacific))
+
+	rec := httptest.NewRecorder()
+	api.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tiles/4/13/6", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	var body map[string]string
+	getJSON(t, api, "/tiles/4/16/6.mvt", http.StatusBadRequest, &body)
+}
+
+func TestTileCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	var bbox BBox
+	cache := newTileCache(2)
+	cache.add(0, "a", bbox, []byte("a"))
+	cache.add(0, "b", bbox, []byte("b"))
+	_, ok := cache.get(0, "a")
+	require.True(t, ok)
+	cache.add(0, "c", bbox, []byte("c"))
+	_, ok = cache.get(0, "b")
+	require.False(t, ok)
+	data, ok := cache.get(0, "a")
+	require.True(t, ok)
+	require.Equal(t, []byte("a"), data)
+}
+
+func TestTileCacheInvalidatesTilesOfNewClaims(t *testing.T) {
+	seoul := Tile{Z: 8, X: 218, Y: 99}
+	london := Tile{Z: 8, X: 127, Y: 85}
+	cache := newTileCache(10)
+	cache.add(0, "seoul", seoul.BBox(), []byte("seoul"))
+	cache.add(0, "london", london.BBox(), []byte("london"))
+
+	var queried [][2]int64
+	indexed := func(from, to int64) ([]Location, error) {
+		queried = append(queried, [2]int64{from, to})
+		return []Location{{Lon: 126_978_000, Lat: 37_566_500}}, nil
+	}
+	require.NoError(t, cache.advance(3, indexed))
+	require.Equal(t, [][2]int64{{0, 3}}, queried)
+	_, ok := cache.get(3, "seoul")
+	require.False(t, ok)
+	data, ok := cache.get(3, "london")
+	require.True(t, ok)
+	require.Equal(t, []byte("london"), data)
+
+	// 이미 지난 높이로는 되돌아가지 않고, 이전 높이의 타일은 캐시하지도 반환하지도 않음
+	require.NoError(t, cache.advance(2, indexed))
+	require.Len(t, queried, 1)
+	cache.add(2, "seoul", seoul.BBox(), []byte("stale"))
+	_, ok = cache.get(3, "seoul")
+	require.False(t, ok)
+	_, ok = cache.get(2, "london")
+	require.False(t, ok)
+
+	// 조회에 실패하면 캐시를 그대로 둠
+	require.Error(t, cache.advance(4, func(int64, int64) ([]Location, error) { return nil, errors.New("db closed") }))
+	_, ok = cache.get(3, "london")
+	require.True(t, ok)
+}
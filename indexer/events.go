@@ -0,0 +1,80 @@
+package indexer
+
+import (
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	realitytypes "contactical/x/reality/types"
+)
+
+// newBlock collects the x/reality typed events of a block. txs are the raw
+// transactions of the block and results their execution results, in the same
+// order; events of failed transactions are ignored.
+func newBlock(height int64, blockTime time.Time, txs []cmttypes.Tx, results []*abci.ExecTxResult, blockEvents []abci.Event) (Block, error) {
+	if len(txs) != len(results) {
+		return Block{}, fmt.Errorf("block %d has %d txs but %d results", height, len(txs), len(results))
+	}
+	block := Block{
+		Height:  height,
+		Time:    blockTime,
+		Rewards: make(map[Position]Reward),
+		Swaps:   make(map[Position]Swap),
+	}
+	for i, res := range results {
+		if res.Code != 0 {
+			continue
+		}
+		block.add(i, fmt.Sprintf("%X", txs[i].Hash()), res.Events)
+	}
+	block.add(-1, "", blockEvents)
+	return block, nil
+}
+
+// add records the typed events of one transaction, or of the block itself
+// when txIndex is -1.
+func (b *Block) add(txIndex int, txHash string, events []abci.Event) {
+	for i, event := range events {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			// 타입 이벤트가 아닌 이벤트(bank, message 등)는 건너뜀
+			continue
+		}
+		pos := Position{TxIndex: txIndex, EventIndex: i, TxHash: txHash}
+		switch e := msg.(type) {
+		case *realitytypes.EventClaimCreated:
+			b.Claims = append(b.Claims, Claim{
+				ID:               e.ClaimId,
+				NodeID:           e.NodeId,
+				Relayer:          e.Relayer,
+				SensorHash:       e.SensorHash,
+				Latitude:         e.Latitude,
+				Longitude:        e.Longitude,
+				Region:           e.Region,
+				TrustScore:       e.TrustScore,
+				RewardMultiplier: e.RewardMultiplier,
+				RewardPoints:     e.RewardPoints,
+				RewardEpoch:      e.RewardEpoch,
+				Height:           b.Height,
+				Time:             b.Time,
+				TxHash:           txHash,
+			})
+		case *realitytypes.EventNodeRegistered:
+			b.Nodes = append(b.Nodes, Node{
+				ID:            e.NodeId,
+				TrustTier:     e.TrustTier,
+				SecurityLevel: e.SecurityLevel,
+				Bond:          e.Bond.String(),
+				Height:        b.Height,
+				Time:          b.Time,
+			})
+		case *realitytypes.EventRewardPaid:
+			b.Rewards[pos] = Reward{NodeID: e.NodeId, Receiver: e.Receiver, Amount: e.Amount}
+		case *realitytypes.EventSwap:
+			b.Swaps[pos] = Swap{Creator: e.Creator, AmountIn: e.AmountIn, AmountOut: e.AmountOut, Route: e.Route}
+		}
+	}
+}
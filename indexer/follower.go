@@ -0,0 +1,94 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+)
+
+// DefaultPollInterval is how often a Follower checks for new blocks.
+const DefaultPollInterval = 2 * time.Second
+
+// ChainClient is the part of the CometBFT RPC client used by a Follower.
+type ChainClient interface {
+	Status(ctx context.Context) (*ctypes.ResultStatus, error)
+	Block(ctx context.Context, height *int64) (*ctypes.ResultBlock, error)
+	BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error)
+}
+
+// Follower indexes the blocks of a chain into a Store as they are committed.
+type Follower struct {
+	client   ChainClient
+	store    *Store
+	start    int64
+	interval time.Duration
+}
+
+// NewFollower returns a follower indexing from the block following the last
+// indexed one, or from start when the store is empty.
+func NewFollower(client ChainClient, store *Store, start int64, interval time.Duration) *Follower {
+	return &Follower{client: client, store: store, start: max(start, 1), interval: interval}
+}
+
+// Sync indexes the blocks committed since the last indexed one and returns
+// the height reached.
+func (f *Follower) Sync(ctx context.Context) (int64, error) {
+	height, err := f.store.Height(ctx)
+	if err != nil {
+		return 0, err
+	}
+	status, err := f.client.Status(ctx)
+	if err != nil {
+		return height, fmt.Errorf("failed to query chain status: %w", err)
+	}
+	latest := status.SyncInfo.LatestBlockHeight
+
+	for next := max(height+1, f.start); next <= latest; next++ {
+		if err := ctx.Err(); err != nil {
+			return height, err
+		}
+		if err := f.index(ctx, next); err != nil {
+			return height, err
+		}
+		height = next
+	}
+	return height, nil
+}
+
+func (f *Follower) index(ctx context.Context, height int64) error {
+	block, err := f.client.Block(ctx, &height)
+	if err != nil {
+		return fmt.Errorf("failed to fetch block %d: %w", height, err)
+	}
+	results, err := f.client.BlockResults(ctx, &height)
+	if err != nil {
+		return fmt.Errorf("failed to fetch results of block %d: %w", height, err)
+	}
+	indexed, err := newBlock(height, block.Block.Time, block.Block.Txs, results.TxsResults, results.FinalizeBlockEvents)
+	if err != nil {
+		return err
+	}
+	if err := f.store.Apply(ctx, indexed); err != nil {
+		return fmt.Errorf("failed to index block %d: %w", height, err)
+	}
+	return nil
+}
+
+// Run indexes new blocks every poll interval until ctx is done. Errors are
+// passed to onError and the blocks retried on the next poll.
+func (f *Follower) Run(ctx context.Context, onError func(error)) {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+	for {
+		if _, err := f.Sync(ctx); err != nil && ctx.Err() == nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package indexer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	realitytypes "contactical/x/reality/types"
+)
+
+var genesisTime = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// fakeTx is a committed transaction of a fakeChain.
+type fakeTx struct {
+	code   uint32
+	events []proto.Message
+}
+
+// fakeChain serves blocks of typed events, one block every minute.
+type fakeChain struct {
+	t      *testing.T
+	blocks [][]fakeTx
+}
+
+func (c *fakeChain) Status(context.Context) (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: int64(len(c.blocks))}}, nil
+}
+
+func (c *fakeChain) Block(_ context.Context, height *int64) (*ctypes.ResultBlock, error) {
+	block := &cmttypes.Block{}
+	block.Height = *height
+	block.Time = genesisTime.Add(time.Duration(*height) * time.Minute)
+	for i := range c.blocks[*height-1] {
+		block.Txs = append(block.Txs, cmttypes.Tx{byte(*height), byte(i)})
+	}
+	return &ctypes.ResultBlock{Block: block}, nil
+}
+
+func (c *fakeChain) BlockResults(_ context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	res := &ctypes.ResultBlockResults{Height: *height}
+	for _, tx := range c.blocks[*height-1] {
+		result := &abci.ExecTxResult{Code: tx.code}
+		for _, msg := range tx.events {
+			event, err := sdk.TypedEventToEvent(msg)
+			require.NoError(c.t, err)
+			result.Events = append(result.Events, abci.Event(event))
+		}
+		res.TxsResults = append(res.TxsResults, result)
+	}
+	return res, nil
+}
+
+func claimEvent(id uint64, node string, lat, lon, score, multiplier int64) *realitytypes.EventClaimCreated {
+	return &realitytypes.EventClaimCreated{
+		ClaimId:          id,
+		NodeId:           node,
+		Relayer:          "relayer",
+		SensorHash:       "hash",
+		Latitude:         lat,
+		Longitude:        lon,
+		Region:           "KR",
+		TrustScore:       score,
+		RewardMultiplier: multiplier,
+		RewardPoints:     score * multiplier,
+	}
+}
+
+// testChain is a chain of three blocks: two node registrations, four claims
+// in Seoul and Busan, one failed claim and a reward payout.
+func testChain(t *testing.T) *fakeChain {
+	return &fakeChain{t: t, blocks: [][]fakeTx{
+		{
+			{events: []proto.Message{&realitytypes.EventNodeRegistered{NodeId: "node-a", TrustTier: 2, SecurityLevel: 3, Bond: sdk.NewInt64Coin("stake", 100)}}},
+			{events: []proto.Message{&realitytypes.EventNodeRegistered{NodeId: "node-b", TrustTier: 1, SecurityLevel: 1, Bond: sdk.NewInt64Coin("stake", 50)}}},
+		},
+		{
+			{events: []proto.Message{
+				claimEvent(1, "node-a", 37_566_500, 126_978_000, 90, 1),
+				claimEvent(2, "node-a", 37_567_000, 126_979_000, 70, 2),
+			}},
+			{code: 5, events: []proto.Message{claimEvent(3, "node-b", 37_566_000, 126_978_500, 10, 1)}},
+		},
+		{
+			{events: []proto.Message{
+				claimEvent(4, "node-b", 35_179_500, 129_075_600, 40, 1),
+				claimEvent(5, "node-c", 37_566_800, 126_978_300, 55, 1),
+			}},
+			{events: []proto.Message{&realitytypes.EventRewardPaid{NodeId: "node-a", Receiver: "owner", Amount: sdk.NewCoins(sdk.NewInt64Coin("reward", 120))}}},
+		},
+	}}
+}
+
+func testStore(t *testing.T) *Store {
+	t.Helper()
+	store, err := OpenStore(context.Background(), DriverSQLite, filepath.Join(t.TempDir(), "indexer.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+// indexedStore returns a store indexing testChain.
+func indexedStore(t *testing.T) *Store {
+	t.Helper()
+	store := testStore(t)
+	height, err := NewFollower(testChain(t), store, 1, time.Second).Sync(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(3), height)
+	return store
+}
+
+func TestFollowerIndexesTypedEvents(t *testing.T) {
+	ctx := context.Background()
+	chain := testChain(t)
+	store := testStore(t)
+	follower := NewFollower(chain, store, 1, time.Second)
+
+	height, err := follower.Sync(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), height)
+
+	claims, err := store.Claims(ctx, ClaimFilter{})
+	require.NoError(t, err)
+	var ids []uint64
+	for _, c := range claims {
+		ids = append(ids, c.ID)
+	}
+	// 실패한 트랜잭션의 클레임 3은 색인되지 않음
+	require.Equal(t, []uint64{5, 4, 2, 1}, ids)
+	require.Equal(t, int64(2), claims[2].Height)
+	require.Equal(t, genesisTime.Add(2*time.Minute), claims[2].Time)
+	require.True(t, claims[2].Emergency())
+	require.NotEmpty(t, claims[2].TxHash)
+
+	nodes, err := store.Nodes(ctx, "", 0)
+	require.NoError(t, err)
+	require.Len(t, nodes, 3)
+	a := nodes[0]
+	require.Equal(t, "node-a", a.ID)
+	require.Equal(t, int32(2), a.TrustTier)
+	require.Equal(t, "100stake", a.Bond)
+	require.Equal(t, int64(1), a.Height)
+	require.Equal(t, int64(2), a.Claims)
+	require.InDelta(t, 80, a.AvgScore, 1e-9)
+	require.Equal(t, int64(230), a.RewardPoints)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("reward", math.NewInt(120))), a.Paid)
+
+	// 등록 이벤트 없이 클레임만 있는 노드도 통계에 포함
+	c, err := store.Nodes(ctx, "node-c", 0)
+	require.NoError(t, err)
+	require.Len(t, c, 1)
+	require.Equal(t, int64(1), c[0].Claims)
+	require.Zero(t, c[0].Height)
+
+	// 새 블록이 없으면 아무것도 하지 않고, 새 블록만 이어서 색인
+	height, err = follower.Sync(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), height)
+	chain.blocks = append(chain.blocks, []fakeTx{{events: []proto.Message{claimEvent(6, "node-b", 35_180_000, 129_076_000, 60, 1)}}})
+	height, err = follower.Sync(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), height)
+	claims, err = store.Claims(ctx, ClaimFilter{NodeID: "node-b"})
+	require.NoError(t, err)
+	require.Len(t, claims, 2)
+}
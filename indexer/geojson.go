@@ -0,0 +1,87 @@
+package indexer
+
+import (
+	"math"
+	"time"
+)
+
+// FeatureCollection is a GeoJSON feature collection.
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	Features []Feature `json:"features"`
+}
+
+// Feature is a GeoJSON feature.
+type Feature struct {
+	Type       string         `json:"type"`
+	Geometry   Geometry       `json:"geometry"`
+	Properties map[string]any `json:"properties"`
+}
+
+// Geometry is a GeoJSON geometry. Only points are served.
+type Geometry struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+func newFeatureCollection(features []Feature) FeatureCollection {
+	if features == nil {
+		features = []Feature{}
+	}
+	return FeatureCollection{Type: "FeatureCollection", Features: features}
+}
+
+// pointFeature returns a point feature at lon, lat in microdegrees.
+func pointFeature(lon, lat int64, properties map[string]any) Feature {
+	return Feature{
+		Type: "Feature",
+		// GeoJSON 좌표는 [경도, 위도] 순서
+		Geometry:   Geometry{Type: "Point", Coordinates: [2]float64{degrees(lon), degrees(lat)}},
+		Properties: properties,
+	}
+}
+
+// claimFeature returns the GeoJSON feature of a claim.
+func claimFeature(c Claim) Feature {
+	return pointFeature(c.Longitude, c.Latitude, map[string]any{
+		"claim_id":          c.ID,
+		"creator":           c.NodeID,
+		"relayer":           c.Relayer,
+		"region":            c.Region,
+		"score":             c.TrustScore,
+		"reward_multiplier": c.RewardMultiplier,
+		"reward_points":     c.RewardPoints,
+		"is_emergency":      c.Emergency(),
+		"height":            c.Height,
+		"time":              c.Time.Format(time.RFC3339),
+	})
+}
+
+// heatmapGrid is the number of heatmap cells along each side of a tile.
+const heatmapGrid = 32
+
+// heatmap returns one feature per non-empty cell of the heatmapGrid grid of a
+// tile, placed at the centroid of its claims. Cell properties are the claim
+// count, the summed trust score as weight and the average score.
+func heatmap(cells []Cell) FeatureCollection {
+	features := make([]Feature, 0, len(cells))
+	for _, cell := range cells {
+		features = append(features, pointFeature(cell.Lon, cell.Lat, map[string]any{
+			"count":       cell.Count,
+			"weight":      cell.TrustScore,
+			"avg_score":   math.Round(float64(cell.TrustScore)/float64(cell.Count)*100) / 100,
+			"emergencies": cell.Emergencies,
+		}))
+	}
+	return newFeatureCollection(features)
+}
+
+// degrees converts microdegrees to degrees.
+func degrees(micro int64) float64 {
+	return float64(micro) / 1e6
+}
+
+// microdegrees converts degrees to microdegrees.
+func microdegrees(deg float64) int64 {
+	return int64(math.Round(deg * 1e6))
+}
@@ -0,0 +1,227 @@
+package indexer
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	// TileExtent is the size of the coordinate space of a vector tile.
+	TileExtent = 4096
+	// ClusterMaxZoom is the deepest zoom level at which claims are clustered.
+	// Deeper tiles hold one feature per claim, unless they hold more than
+	// maxTileClaims claims.
+	ClusterMaxZoom = 14
+	// ClaimsLayer is the name of the vector tile layer holding claims and
+	// clusters.
+	ClaimsLayer = "claims"
+
+	// clusterGrid is the number of cluster cells along each side of a tile.
+	clusterGrid = 16
+)
+
+// tileProperty is a property of a vector tile feature. Properties are kept in
+// a slice rather than a map so that tiles are encoded deterministically.
+type tileProperty struct {
+	key   string
+	value any // string, int64, uint64, float64 또는 bool
+}
+
+// claimProperties returns the vector tile properties of a claim.
+func claimProperties(c Claim) []tileProperty {
+	return []tileProperty{
+		{"claim_id", c.ID},
+		{"node_id", c.NodeID},
+		{"trust_score", c.TrustScore},
+		{"reward_multiplier", c.RewardMultiplier},
+		{"reward_points", c.RewardPoints},
+		{"is_emergency", c.Emergency()},
+	}
+}
+
+// vectorTile encodes the claims of a tile as a Mapbox Vector Tile with a single
+// ClaimsLayer layer holding one point feature per claim, with the properties
+// of claimProperties.
+func vectorTile(tile Tile, claims []Claim) []byte {
+	layer := newTileLayer(ClaimsLayer)
+	for _, c := range claims {
+		px, py := tile.Project(degrees(c.Longitude), degrees(c.Latitude))
+		if px < 0 || px >= 1 || py < 0 || py >= 1 {
+			continue
+		}
+		layer.addPoint(c.ID, int64(px*TileExtent), int64(py*TileExtent), claimProperties(c))
+	}
+	return layer.encode()
+}
+
+// clusterTile encodes the claims of a tile aggregated into the cells of a
+// clusterGrid grid as a Mapbox Vector Tile with a single ClaimsLayer layer.
+// The claims of a cell are merged into a cluster feature placed at their
+// centroid, with the properties cluster, point_count, trust_score (the
+// average), reward_multiplier (the highest), is_emergency and emergencies.
+// Claims alone in their cell are point features with the properties of
+// claimProperties.
+func clusterTile(tile Tile, cells []Cell) []byte {
+	layer := newTileLayer(ClaimsLayer)
+	for _, cell := range cells {
+		px, py := tile.Project(degrees(cell.Lon), degrees(cell.Lat))
+		// 좌표 평균의 내림으로 중심점이 타일 경계를 벗어나지 않도록 제한
+		x := min(max(int64(px*TileExtent), 0), TileExtent-1)
+		y := min(max(int64(py*TileExtent), 0), TileExtent-1)
+		if cell.Count == 1 {
+			c := cell.Claim()
+			layer.addPoint(c.ID, x, y, claimProperties(c))
+			continue
+		}
+		layer.addPoint(0, x, y, []tileProperty{
+			{"cluster", true},
+			{"point_count", uint64(cell.Count)},
+			{"trust_score", math.Round(float64(cell.TrustScore)/float64(cell.Count)*100) / 100},
+			{"reward_multiplier", cell.RewardMultiplier},
+			{"is_emergency", cell.Emergencies > 0},
+			{"emergencies", cell.Emergencies},
+		})
+	}
+	return layer.encode()
+}
+
+// Field numbers of the vector tile protobuf schema, version 2.
+const (
+	mvtTileLayers = 3
+
+	mvtLayerName     = 1
+	mvtLayerFeatures = 2
+	mvtLayerKeys     = 3
+	mvtLayerValues   = 4
+	mvtLayerExtent   = 5
+	mvtLayerVersion  = 15
+
+	mvtFeatureID       = 1
+	mvtFeatureTags     = 2
+	mvtFeatureType     = 3
+	mvtFeatureGeometry = 4
+
+	mvtValueString = 1
+	mvtValueDouble = 3
+	mvtValueInt    = 4
+	mvtValueUint   = 5
+	mvtValueBool   = 7
+
+	mvtGeomPoint = 1
+	mvtMoveTo    = 1
+)
+
+// tileLayer builds a vector tile layer of point features. Keys and values are
+// shared between features through the tables of the layer.
+type tileLayer struct {
+	name     string
+	features [][]byte
+	keys     []string
+	keyIndex map[string]uint64
+	values   [][]byte
+	valIndex map[string]uint64
+}
+
+func newTileLayer(name string) *tileLayer {
+	return &tileLayer{name: name, keyIndex: make(map[string]uint64), valIndex: make(map[string]uint64)}
+}
+
+// addPoint adds a point feature at x, y in tile coordinates. A zero id is
+// omitted.
+func (l *tileLayer) addPoint(id uint64, x, y int64, props []tileProperty) {
+	var tags []byte
+	for _, p := range props {
+		tags = protowire.AppendVarint(tags, l.key(p.key))
+		tags = protowire.AppendVarint(tags, l.value(p.value))
+	}
+	// 점 하나는 MoveTo 명령 하나와 지그재그 인코딩된 좌표로 표현
+	var geometry []byte
+	geometry = protowire.AppendVarint(geometry, mvtMoveTo|1<<3)
+	geometry = protowire.AppendVarint(geometry, protowire.EncodeZigZag(x))
+	geometry = protowire.AppendVarint(geometry, protowire.EncodeZigZag(y))
+
+	var f []byte
+	if id != 0 {
+		f = protowire.AppendTag(f, mvtFeatureID, protowire.VarintType)
+		f = protowire.AppendVarint(f, id)
+	}
+	f = protowire.AppendTag(f, mvtFeatureTags, protowire.BytesType)
+	f = protowire.AppendBytes(f, tags)
+	f = protowire.AppendTag(f, mvtFeatureType, protowire.VarintType)
+	f = protowire.AppendVarint(f, mvtGeomPoint)
+	f = protowire.AppendTag(f, mvtFeatureGeometry, protowire.BytesType)
+	f = protowire.AppendBytes(f, geometry)
+	l.features = append(l.features, f)
+}
+
+func (l *tileLayer) key(key string) uint64 {
+	i, ok := l.keyIndex[key]
+	if !ok {
+		i = uint64(len(l.keys))
+		l.keyIndex[key] = i
+		l.keys = append(l.keys, key)
+	}
+	return i
+}
+
+func (l *tileLayer) value(value any) uint64 {
+	var v []byte
+	switch value := value.(type) {
+	case string:
+		v = protowire.AppendTag(v, mvtValueString, protowire.BytesType)
+		v = protowire.AppendString(v, value)
+	case float64:
+		v = protowire.AppendTag(v, mvtValueDouble, protowire.Fixed64Type)
+		v = protowire.AppendFixed64(v, math.Float64bits(value))
+	case int64:
+		v = protowire.AppendTag(v, mvtValueInt, protowire.VarintType)
+		v = protowire.AppendVarint(v, uint64(value))
+	case uint64:
+		v = protowire.AppendTag(v, mvtValueUint, protowire.VarintType)
+		v = protowire.AppendVarint(v, value)
+	case bool:
+		v = protowire.AppendTag(v, mvtValueBool, protowire.VarintType)
+		v = protowire.AppendVarint(v, protowire.EncodeBool(value))
+	default:
+		panic("unsupported vector tile value type")
+	}
+	i, ok := l.valIndex[string(v)]
+	if !ok {
+		i = uint64(len(l.values))
+		l.valIndex[string(v)] = i
+		l.values = append(l.values, v)
+	}
+	return i
+}
+
+// encode returns the tile holding the layer. A layer without features is
+// omitted, leaving an empty tile.
+func (l *tileLayer) encode() []byte {
+	if len(l.features) == 0 {
+		return []byte{}
+	}
+	var layer []byte
+	layer = protowire.AppendTag(layer, mvtLayerVersion, protowire.VarintType)
+	layer = protowire.AppendVarint(layer, 2)
+	layer = protowire.AppendTag(layer, mvtLayerName, protowire.BytesType)
+	layer = protowire.AppendString(layer, l.name)
+	for _, f := range l.features {
+		layer = protowire.AppendTag(layer, mvtLayerFeatures, protowire.BytesType)
+		layer = protowire.AppendBytes(layer, f)
+	}
+	for _, k := range l.keys {
+		layer = protowire.AppendTag(layer, mvtLayerKeys, protowire.BytesType)
+		layer = protowire.AppendString(layer, k)
+	}
+	for _, v := range l.values {
+		layer = protowire.AppendTag(layer, mvtLayerValues, protowire.BytesType)
+		layer = protowire.AppendBytes(layer, v)
+	}
+	layer = protowire.AppendTag(layer, mvtLayerExtent, protowire.VarintType)
+	layer = protowire.AppendVarint(layer, TileExtent)
+
+	var tile []byte
+	tile = protowire.AppendTag(tile, mvtTileLayers, protowire.BytesType)
+	return protowire.AppendBytes(tile, layer)
+}
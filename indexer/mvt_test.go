@@ -0,0 +1,296 @@
+package indexer
+
+import (
+	"context"
+	"encoding/hex"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// tileFeature is a decoded vector tile point feature.
+type tileFeature struct {
+	id         uint64
+	x, y       int64
+	properties map[string]any
+}
+
+// decodeTile decodes the ClaimsLayer layer of a vector tile.
+func decodeTile(t *testing.T, data []byte) []tileFeature {
+	t.Helper()
+	var layer []byte
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		require.GreaterOrEqual(t, n, 0)
+		data = data[n:]
+		require.Equal(t, protowire.Number(mvtTileLayers), num)
+		require.Equal(t, protowire.BytesType, typ)
+		layer, n = protowire.ConsumeBytes(data)
+		require.GreaterOrEqual(t, n, 0)
+		data = data[n:]
+	}
+	if layer == nil {
+		return nil
+	}
+
+	var name string
+	var version, extent uint64
+	var rawFeatures [][]byte
+	var keys []string
+	var values []any
+	for len(layer) > 0 {
+		num, typ, n := protowire.ConsumeTag(layer)
+		require.GreaterOrEqual(t, n, 0)
+		layer = layer[n:]
+		switch num {
+		case mvtLayerVersion, mvtLayerExtent:
+			v, n := protowire.ConsumeVarint(layer)
+			require.GreaterOrEqual(t, n, 0)
+			layer = layer[n:]
+			if num == mvtLayerVersion {
+				version = v
+			} else {
+				extent = v
+			}
+		default:
+			require.Equal(t, protowire.BytesType, typ)
+			b, n := protowire.ConsumeBytes(layer)
+			require.GreaterOrEqual(t, n, 0)
+			layer = layer[n:]
+			switch num {
+			case mvtLayerName:
+				name = string(b)
+			case mvtLayerFeatures:
+				rawFeatures = append(rawFeatures, b)
+			case mvtLayerKeys:
+				keys = append(keys, string(b))
+			case mvtLayerValues:
+				values = append(values, decodeValue(t, b))
+			}
+		}
+	}
+	require.Equal(t, ClaimsLayer, name)
+	require.Equal(t, uint64(2), version)
+	require.Equal(t, uint64(TileExtent), extent)
+
+	var features []tileFeature
+	for _, raw := range rawFeatures {
+		f := tileFeature{properties: make(map[string]any)}
+		for len(raw) > 0 {
+			num, _, n := protowire.ConsumeTag(raw)
+			require.GreaterOrEqual(t, n, 0)
+			raw = raw[n:]
+			switch num {
+			case mvtFeatureID, mvtFeatureType:
+				v, n := protowire.ConsumeVarint(raw)
+				require.GreaterOrEqual(t, n, 0)
+				raw = raw[n:]
+				if num == mvtFeatureID {
+					f.id = v
+				} else {
+					require.Equal(t, uint64(mvtGeomPoint), v)
+				}
+			case mvtFeatureTags:
+				tags, n := protowire.ConsumeBytes(raw)
+				require.GreaterOrEqual(t, n, 0)
+				raw = raw[n:]
+				packed := consumePacked(t, tags)
+				require.Zero(t, len(packed)%2)
+				for i := 0; i < len(packed); i += 2 {
+					f.properties[keys[packed[i]]] = values[packed[i+1]]
+				}
+			case mvtFeatureGeometry:
+				geometry, n := protowire.ConsumeBytes(raw)
+				require.GreaterOrEqual(t, n, 0)
+				raw = raw[n:]
+				packed := consumePacked(t, geometry)
+				require.Equal(t, []uint64{mvtMoveTo | 1<<3}, packed[:1])
+				require.Len(t, packed, 3)
+				f.x, f.y = protowire.DecodeZigZag(packed[1]), protowire.DecodeZigZag(packed[2])
+			}
+		}
+		features = append(features, f)
+	}
+	return features
+}
+
+func consumePacked(t *testing.T, b []byte) []uint64 {
+	var out []uint64
+	for len(b) > 0 {
+		v, n := protowire.ConsumeVarint(b)
+		require.GreaterOrEqual(t, n, 0)
+		b = b[n:]
+		out = append(out, v)
+	}
+	return out
+}
+
+func decodeValue(t *testing.T, b []byte) any {
+	num, _, n := protowire.ConsumeTag(b)
+	require.GreaterOrEqual(t, n, 0)
+	b = b[n:]
+	switch num {
+	case mvtValueString:
+		s, _ := protowire.ConsumeString(b)
+		return s
+	case mvtValueDouble:
+		v, _ := protowire.ConsumeFixed64(b)
+		return math.Float64frombits(v)
+	case mvtValueInt:
+		v, _ := protowire.ConsumeVarint(b)
+		return int64(v)
+	case mvtValueUint:
+		v, _ := protowire.ConsumeVarint(b)
+		return v
+	case mvtValueBool:
+		v, _ := protowire.ConsumeVarint(b)
+		return protowire.DecodeBool(v)
+	}
+	t.Fatalf("unexpected value field %d", num)
+	return nil
+}
+
+// fixtureClaims returns 100 claims on a 10x10 grid spaced by 20 microdegrees
+// in the middle of tile 16/55883/25378 in Seoul, every tenth an emergency,
+// followed by one claim in Suwon.
+func fixtureClaims() []Claim {
+	var claims []Claim
+	for i := range int64(100) {
+		multiplier := int64(1)
+		if i%10 == 0 {
+			multiplier = 2
+		}
+		claims = append(claims, Claim{
+			ID:               uint64(i + 1),
+			NodeID:           "node-a",
+			Latitude:         37_568_428 + i/10*20,
+			Longitude:        126_977_134 + i%10*20,
+			TrustScore:       50 + i%50,
+			RewardMultiplier: multiplier,
+			RewardPoints:     (50 + i%50) * multiplier,
+		})
+	}
+	return append(claims, Claim{
+		ID:               101,
+		NodeID:           "node-b",
+		Latitude:         37_263_600,
+		Longitude:        127_028_600,
+		TrustScore:       30,
+		RewardMultiplier: 1,
+		RewardPoints:     30,
+	})
+}
+
+// fixtureCells returns the cells of a grid×grid grid over tile holding
+// fixtureClaims.
+func fixtureCells(t *testing.T, tile Tile, grid int) []Cell {
+	t.Helper()
+	ctx := context.Background()
+	store := testStore(t)
+	require.NoError(t, store.Apply(ctx, Block{Height: 1, Time: genesisTime, Claims: fixtureClaims()}))
+	cells, err := store.ClaimCells(ctx, ClaimFilter{}, tile, grid)
+	require.NoError(t, err)
+	return cells
+}
+
+func TestClusterTileLowZooms(t *testing.T) {
+	tile := Tile{Z: 8, X: 218, Y: 99}
+	features := decodeTile(t, clusterTile(tile, fixtureCells(t, tile, clusterGrid)))
+	require.Len(t, features, 2)
+
+	// 서울 격자는 하나의 클러스터로, 셀에 홀로 있는 수원 클레임은 점으로 인코딩
+	cluster := features[0]
+	require.Zero(t, cluster.id)
+	require.Equal(t, map[string]any{
+		"cluster":           true,
+		"point_count":       uint64(100),
+		"trust_score":       74.5,
+		"reward_multiplier": int64(2),
+		"is_emergency":      true,
+		"emergencies":       int64(10),
+	}, cluster.properties)
+	require.Equal(t, int64(1207), cluster.x)
+	require.Equal(t, int64(552), cluster.y)
+
+	suwon := features[1]
+	require.Equal(t, uint64(101), suwon.id)
+	require.Equal(t, map[string]any{
+		"claim_id":          uint64(101),
+		"node_id":           "node-b",
+		"trust_score":       int64(30),
+		"reward_multiplier": int64(1),
+		"reward_points":     int64(30),
+		"is_emergency":      false,
+	}, suwon.properties)
+
+	// 타일 밖의 클레임은 집계되지 않고, 클레임이 없는 타일은 비어 있음
+	require.Empty(t, fixtureCells(t, Tile{Z: 8, X: 219, Y: 99}, clusterGrid))
+	require.Empty(t, clusterTile(Tile{Z: 8, X: 219, Y: 99}, nil))
+}
+
+func TestClaimCellsMatchProjection(t *testing.T) {
+	// 서울 격자가 여러 셀에 걸치는 타일에서 SQL 격자는 Go 투영과 같은 셀을 계산
+	px, py := Tile{Z: 18}.Project(126.977134, 37.568428)
+	tile := Tile{Z: 18, X: int(px), Y: int(py)}
+	counts := make(map[[2]int]int64)
+	for _, c := range fixtureClaims() {
+		px, py := tile.Project(degrees(c.Longitude), degrees(c.Latitude))
+		if px >= 0 && px < 1 && py >= 0 && py < 1 {
+			counts[[2]int{int(py * heatmapGrid), int(px * heatmapGrid)}]++
+		}
+	}
+	require.Greater(t, len(counts), 4)
+	cells := fixtureCells(t, tile, heatmapGrid)
+	require.Len(t, cells, len(counts))
+	for _, cell := range cells {
+		require.Equal(t, counts[[2]int{cell.Row, cell.Col}], cell.Count, "cell %d,%d", cell.Row, cell.Col)
+	}
+}
+
+func TestClaimCellsFirstClaim(t *testing.T) {
+	ctx := context.Background()
+	store := testStore(t)
+	claims := []Claim{
+		{ID: 1, NodeID: "node-z", Latitude: 37_568_428, Longitude: 126_977_134, TrustScore: 50, RewardMultiplier: 1},
+		{ID: 2, NodeID: "node-a", Latitude: 37_568_448, Longitude: 126_977_154, TrustScore: 70, RewardMultiplier: 1},
+	}
+	require.NoError(t, store.Apply(ctx, Block{Height: 1, Time: genesisTime, Claims: claims}))
+
+	// 셀의 노드 ID는 사전순으로 가장 작은 것이 아니라 가장 작은 클레임 ID의 것
+	cells, err := store.ClaimCells(ctx, ClaimFilter{}, Tile{Z: 8, X: 218, Y: 99}, clusterGrid)
+	require.NoError(t, err)
+	require.Len(t, cells, 1)
+	require.Equal(t, int64(2), cells[0].Count)
+	require.Equal(t, uint64(1), cells[0].ClaimID)
+	require.Equal(t, "node-z", cells[0].NodeID)
+}
+
+func TestVectorTilePointsDeepZooms(t *testing.T) {
+	tile := Tile{Z: ClusterMaxZoom + 2, X: 55883, Y: 25378}
+	features := decodeTile(t, vectorTile(tile, fixtureClaims()))
+	require.Len(t, features, 100)
+	for i, f := range features {
+		require.Equal(t, uint64(i+1), f.id)
+		require.Equal(t, uint64(i+1), f.properties["claim_id"])
+		require.Equal(t, i%10 == 0, f.properties["is_emergency"])
+		require.True(t, f.x >= 0 && f.x < TileExtent && f.y >= 0 && f.y < TileExtent)
+	}
+	// 격자는 동쪽으로 x가, 북쪽으로 y가 감소하는 방향으로 배치됨
+	require.Greater(t, features[1].x, features[0].x)
+	require.Less(t, features[10].y, features[0].y)
+}
+
+func TestVectorTileIsDeterministic(t *testing.T) {
+	tile := Tile{Z: 8, X: 218, Y: 99}
+	require.Equal(t, vectorTile(tile, fixtureClaims()), vectorTile(tile, fixtureClaims()))
+
+	// 인코딩 형식을 고정하기 위한 클레임 하나의 타일
+	claim := Claim{ID: 7, NodeID: "n", Latitude: 0, Longitude: 0, TrustScore: 90, RewardMultiplier: 2, RewardPoints: 180}
+	require.Equal(t,
+		"1a920178020a06636c61696d7312190807120c0000010102020303040405051801220509802080201a08636c61696d5f69641a076e6f64655f"+
+			"69641a0b74727573745f73636f72651a117265776172645f6d756c7469706c6965721a0d7265776172645f706f696e74731a0c69735f656d"+
+			"657267656e63792202280722030a016e2202205a22022002220320b40122023801288020",
+		hex.EncodeToString(vectorTile(Tile{}, []Claim{claim})))
+}
@@ -0,0 +1,544 @@
+// Package indexer follows the blocks of a Contactical chain, records the
+// x/reality typed events into a SQL database and serves the indexed claims as
+// GeoJSON.
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/lib/pq"  // postgres 드라이버
+	_ "modernc.org/sqlite" // sqlite 드라이버
+)
+
+// Supported database drivers.
+const (
+	DriverSQLite   = "sqlite"
+	DriverPostgres = "postgres"
+)
+
+// schema creates the tables of the index. It is valid for both SQLite and
+// Postgres. Coordinates are stored in microdegrees like on chain and times in
+// unix seconds.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS cursor (
+		name TEXT PRIMARY KEY,
+		height BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS claims (
+		id BIGINT PRIMARY KEY,
+		node_id TEXT NOT NULL,
+		relayer TEXT NOT NULL,
+		sensor_hash TEXT NOT NULL,
+		latitude BIGINT NOT NULL,
+		longitude BIGINT NOT NULL,
+		region TEXT NOT NULL,
+		trust_score BIGINT NOT NULL,
+		reward_multiplier BIGINT NOT NULL,
+		reward_points BIGINT NOT NULL,
+		reward_epoch BIGINT NOT NULL,
+		height BIGINT NOT NULL,
+		block_time BIGINT NOT NULL,
+		tx_hash TEXT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS claims_block_time ON claims (block_time)`,
+	`CREATE INDEX IF NOT EXISTS claims_location ON claims (latitude, longitude)`,
+	`CREATE INDEX IF NOT EXISTS claims_node ON claims (node_id)`,
+	`CREATE INDEX IF NOT EXISTS claims_height ON claims (height)`,
+	`CREATE TABLE IF NOT EXISTS nodes (
+		node_id TEXT PRIMARY KEY,
+		trust_tier INTEGER NOT NULL,
+		security_level INTEGER NOT NULL,
+		bond TEXT NOT NULL,
+		registered_height BIGINT NOT NULL,
+		registered_time BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS rewards (
+		height BIGINT NOT NULL,
+		tx_index INTEGER NOT NULL,
+		event_index INTEGER NOT NULL,
+		denom TEXT NOT NULL,
+		node_id TEXT NOT NULL,
+		receiver TEXT NOT NULL,
+		amount TEXT NOT NULL,
+		block_time BIGINT NOT NULL,
+		tx_hash TEXT NOT NULL,
+		PRIMARY KEY (height, tx_index, event_index, denom)
+	)`,
+	`CREATE INDEX IF NOT EXISTS rewards_node ON rewards (node_id)`,
+	`CREATE TABLE IF NOT EXISTS swaps (
+		height BIGINT NOT NULL,
+		tx_index INTEGER NOT NULL,
+		event_index INTEGER NOT NULL,
+		creator TEXT NOT NULL,
+		amount_in TEXT NOT NULL,
+		amount_out TEXT NOT NULL,
+		route TEXT NOT NULL,
+		block_time BIGINT NOT NULL,
+		tx_hash TEXT NOT NULL,
+		PRIMARY KEY (height, tx_index, event_index)
+	)`,
+}
+
+// Claim is an indexed claim.
+type Claim struct {
+	ID               uint64
+	NodeID           string
+	Relayer          string
+	SensorHash       string
+	Latitude         int64
+	Longitude        int64
+	Region           string
+	TrustScore       int64
+	RewardMultiplier int64
+	RewardPoints     int64
+	RewardEpoch      uint64
+	Height           int64
+	Time             time.Time
+	TxHash           string
+}
+
+// Emergency reports whether the claim was made in a high priority area,
+// which doubles its reward multiplier.
+func (c Claim) Emergency() bool {
+	return c.RewardMultiplier > 1
+}
+
+// Node is an indexed node registration.
+type Node struct {
+	ID            string
+	TrustTier     int32
+	SecurityLevel int32
+	Bond          string
+	Height        int64
+	Time          time.Time
+}
+
+// Reward is a payout of vested rewards to a node.
+type Reward struct {
+	NodeID   string
+	Receiver string
+	Amount   sdk.Coins
+}
+
+// Swap is a swap through the pools.
+type Swap struct {
+	Creator   string
+	AmountIn  sdk.Coin
+	AmountOut sdk.Coin
+	Route     []uint64
+}
+
+// Position locates an event in a block. TxIndex is -1 for the events of the
+// block itself.
+type Position struct {
+	TxIndex    int
+	EventIndex int
+	TxHash     string
+}
+
+// Block holds the indexed events of one block.
+type Block struct {
+	Height  int64
+	Time    time.Time
+	Claims  []Claim
+	Nodes   []Node
+	Rewards map[Position]Reward
+	Swaps   map[Position]Swap
+}
+
+// Store is the SQL database of the index.
+type Store struct {
+	db *sql.DB
+}
+
+// OpenStore opens the database dsn with driver, DriverSQLite or
+// DriverPostgres, and creates the tables of the index.
+func OpenStore(ctx context.Context, driver, dsn string) (*Store, error) {
+	if driver != DriverSQLite && driver != DriverPostgres {
+		return nil, fmt.Errorf("unsupported database driver %q, expected %s or %s", driver, DriverSQLite, DriverPostgres)
+	}
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if driver == DriverSQLite {
+		// SQLite는 쓰기 연결이 하나뿐이므로 잠금 경합을 피하기 위해 연결을 하나로 제한
+		db.SetMaxOpenConns(1)
+	}
+	for _, stmt := range schema {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("failed to create schema: %w", err)
+		}
+	}
+	return &Store{db: db}, nil
+}
+
+// Close closes the database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Height returns the height of the last indexed block, 0 if none.
+func (s *Store) Height(ctx context.Context) (int64, error) {
+	var height int64
+	err := s.db.QueryRowContext(ctx, `SELECT height FROM cursor WHERE name = 'blocks'`).Scan(&height)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return height, err
+}
+
+// Apply records the events of a block and advances the cursor to its height
+// in a single transaction. Applying a block again is a no-op.
+func (s *Store) Apply(ctx context.Context, block Block) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	blockTime := block.Time.Unix()
+	for _, n := range block.Nodes {
+		// 재등록 시 최초 등록 시점은 유지하고 등급과 보증금만 갱신
+		if _, err := tx.ExecContext(ctx, `INSERT INTO nodes (node_id, trust_tier, security_level, bond, registered_height, registered_time)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (node_id) DO UPDATE SET trust_tier = excluded.trust_tier, security_level = excluded.security_level, bond = excluded.bond`,
+			n.ID, n.TrustTier, n.SecurityLevel, n.Bond, block.Height, blockTime); err != nil {
+			return fmt.Errorf("failed to index node %s: %w", n.ID, err)
+		}
+	}
+	for _, c := range block.Claims {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO claims (id, node_id, relayer, sensor_hash, latitude, longitude, region,
+				trust_score, reward_multiplier, reward_points, reward_epoch, height, block_time, tx_hash)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+			ON CONFLICT (id) DO NOTHING`,
+			c.ID, c.NodeID, c.Relayer, c.SensorHash, c.Latitude, c.Longitude, c.Region,
+			c.TrustScore, c.RewardMultiplier, c.RewardPoints, c.RewardEpoch, block.Height, blockTime, c.TxHash); err != nil {
+			return fmt.Errorf("failed to index claim %d: %w", c.ID, err)
+		}
+		// 색인 시작 이전에 등록된 노드도 통계에 나오도록 빈 노드 행 생성
+		if _, err := tx.ExecContext(ctx, `INSERT INTO nodes (node_id, trust_tier, security_level, bond, registered_height, registered_time)
+			VALUES ($1, 0, 0, '', 0, 0) ON CONFLICT (node_id) DO NOTHING`, c.NodeID); err != nil {
+			return fmt.Errorf("failed to index node %s: %w", c.NodeID, err)
+		}
+	}
+	for pos, r := range block.Rewards {
+		for _, coin := range r.Amount {
+			if _, err := tx.ExecContext(ctx, `INSERT INTO rewards (height, tx_index, event_index, denom, node_id, receiver, amount, block_time, tx_hash)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+				ON CONFLICT (height, tx_index, event_index, denom) DO NOTHING`,
+				block.Height, pos.TxIndex, pos.EventIndex, coin.Denom, r.NodeID, r.Receiver, coin.Amount.String(), blockTime, pos.TxHash); err != nil {
+				return fmt.Errorf("failed to index reward of %s: %w", r.NodeID, err)
+			}
+		}
+	}
+	for pos, sw := range block.Swaps {
+		route := make([]string, len(sw.Route))
+		for i, id := range sw.Route {
+			route[i] = fmt.Sprint(id)
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO swaps (height, tx_index, event_index, creator, amount_in, amount_out, route, block_time, tx_hash)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			ON CONFLICT (height, tx_index, event_index) DO NOTHING`,
+			block.Height, pos.TxIndex, pos.EventIndex, sw.Creator, sw.AmountIn.String(), sw.AmountOut.String(), strings.Join(route, ","), blockTime, pos.TxHash); err != nil {
+			return fmt.Errorf("failed to index swap of %s: %w", sw.Creator, err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `INSERT INTO cursor (name, height) VALUES ('blocks', $1)
+		ON CONFLICT (name) DO UPDATE SET height = excluded.height`, block.Height); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// BBox is a bounding box in microdegrees.
+type BBox struct {
+	MinLon, MinLat, MaxLon, MaxLat int64
+}
+
+// Location is a point in microdegrees.
+type Location struct {
+	Lon, Lat int64
+}
+
+// Contains reports whether l is in the box, borders included.
+func (b BBox) Contains(l Location) bool {
+	return l.Lon >= b.MinLon && l.Lon <= b.MaxLon && l.Lat >= b.MinLat && l.Lat <= b.MaxLat
+}
+
+// ClaimFilter selects indexed claims. Zero fields do not filter.
+type ClaimFilter struct {
+	BBox     *BBox
+	From, To time.Time
+	MinScore *int64
+	MaxScore *int64
+	NodeID   string
+	// Limit bounds the number of claims returned, the most recent first.
+	Limit int
+}
+
+// where returns the SQL conditions and arguments of the filter.
+func (f ClaimFilter) where() (string, []any) {
+	var conds []string
+	var args []any
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if f.BBox != nil {
+		add("latitude >= $%d", f.BBox.MinLat)
+		add("latitude <= $%d", f.BBox.MaxLat)
+		add("longitude >= $%d", f.BBox.MinLon)
+		add("longitude <= $%d", f.BBox.MaxLon)
+	}
+	if !f.From.IsZero() {
+		add("block_time >= $%d", f.From.Unix())
+	}
+	if !f.To.IsZero() {
+		add("block_time < $%d", f.To.Unix())
+	}
+	if f.MinScore != nil {
+		add("trust_score >= $%d", *f.MinScore)
+	}
+	if f.MaxScore != nil {
+		add("trust_score <= $%d", *f.MaxScore)
+	}
+	if f.NodeID != "" {
+		add("node_id = $%d", f.NodeID)
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conds, " AND "), args
+}
+
+// Claims returns the claims matching the filter, the most recent first.
+func (s *Store) Claims(ctx context.Context, f ClaimFilter) ([]Claim, error) {
+	where, args := f.where()
+	query := `SELECT id, node_id, relayer, sensor_hash, latitude, longitude, region, trust_score, reward_multiplier,
+		reward_points, reward_epoch, height, block_time, tx_hash FROM claims` + where + ` ORDER BY block_time DESC, id DESC`
+	if f.Limit > 0 {
+		args = append(args, f.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var claims []Claim
+	for rows.Next() {
+		var c Claim
+		var blockTime int64
+		if err := rows.Scan(&c.ID, &c.NodeID, &c.Relayer, &c.SensorHash, &c.Latitude, &c.Longitude, &c.Region, &c.TrustScore,
+			&c.RewardMultiplier, &c.RewardPoints, &c.RewardEpoch, &c.Height, &blockTime, &c.TxHash); err != nil {
+			return nil, err
+		}
+		c.Time = time.Unix(blockTime, 0).UTC()
+		claims = append(claims, c)
+	}
+	return claims, rows.Err()
+}
+
+// ClaimLocations returns the locations of the claims indexed in the blocks
+// above from up to to.
+func (s *Store) ClaimLocations(ctx context.Context, from, to int64) ([]Location, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT longitude, latitude FROM claims WHERE height > $1 AND height <= $2`, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var locations []Location
+	for rows.Next() {
+		var l Location
+		if err := rows.Scan(&l.Lon, &l.Lat); err != nil {
+			return nil, err
+		}
+		locations = append(locations, l)
+	}
+	return locations, rows.Err()
+}
+
+// Cell aggregates the claims in one cell of a grid laid over a tile.
+type Cell struct {
+	Row, Col int
+	Count    int64
+	// Lon and Lat are the centroid of the claims.
+	Lon, Lat         int64
+	TrustScore       int64 // 합계
+	RewardMultiplier int64 // 최댓값
+	RewardPoints     int64 // 합계
+	Emergencies      int64
+	// ClaimID and NodeID are those of the lowest claim id of the cell.
+	ClaimID uint64
+	NodeID  string
+}
+
+// Claim returns the claim of a cell holding a single claim, with the fields
+// kept by the aggregation.
+func (c Cell) Claim() Claim {
+	return Claim{
+		ID:               c.ClaimID,
+		NodeID:           c.NodeID,
+		Latitude:         c.Lat,
+		Longitude:        c.Lon,
+		TrustScore:       c.TrustScore,
+		RewardMultiplier: c.RewardMultiplier,
+		RewardPoints:     c.RewardPoints,
+	}
+}
+
+// ClaimCells aggregates the claims matching the filter inside tile by the
+// cells of a grid×grid grid laid over it, ordered by row then column. The
+// aggregation runs in the database, so that tiles holding any number of claims
+// are served whole. The bounding box of the filter is replaced by the tile and
+// its limit is ignored.
+func (s *Store) ClaimCells(ctx context.Context, f ClaimFilter, tile Tile, grid int) ([]Cell, error) {
+	bbox := tile.BBox()
+	f.BBox = &bbox
+	where, args := f.where()
+
+	// 열은 경도에 선형이므로 정수 연산으로, 행은 메르카토르 투영이 비선형이므로
+	// 격자선의 위도와 비교해 계산. 타일 밖의 클레임은 -1 또는 grid가 됨
+	n := int64(1) << tile.Z
+	col := fmt.Sprintf("(longitude + 180000000) * %d / 360000000 - %d", n*int64(grid), int64(tile.X)*int64(grid))
+	var row strings.Builder
+	row.WriteString("CASE")
+	for i := 0; i <= grid; i++ {
+		lat := tile.latitude(float64(tile.Y)+float64(i)/float64(grid)) * 1e6
+		fmt.Fprintf(&row, " WHEN latitude > %s THEN %d", strconv.FormatFloat(lat, 'f', -1, 64), i-1)
+	}
+	row.WriteString(" ELSE -1 END")
+
+	// 노드 ID는 셀에서 가장 작은 클레임 ID의 것이므로 집계 후 클레임과 다시 조인
+	query := fmt.Sprintf(`SELECT g.cell_row, g.cell_col, g.claims, g.lon, g.lat, g.trust_score, g.reward_multiplier,
+		g.reward_points, g.emergencies, g.first_id, c.node_id
+		FROM (SELECT cell_row, cell_col, COUNT(*) AS claims, SUM(longitude) AS lon, SUM(latitude) AS lat,
+			SUM(trust_score) AS trust_score, MAX(reward_multiplier) AS reward_multiplier, SUM(reward_points) AS reward_points,
+			SUM(CASE WHEN reward_multiplier > 1 THEN 1 ELSE 0 END) AS emergencies, MIN(id) AS first_id
+			FROM (SELECT id, longitude, latitude, trust_score, reward_multiplier, reward_points,
+				%s AS cell_row, %s AS cell_col FROM claims%s) AS cells
+			WHERE cell_row >= 0 AND cell_col >= 0 AND cell_col < %d
+			GROUP BY cell_row, cell_col) AS g
+		JOIN claims c ON c.id = g.first_id
+		ORDER BY g.cell_row, g.cell_col`, row.String(), col, where, grid)
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var cells []Cell
+	for rows.Next() {
+		var c Cell
+		if err := rows.Scan(&c.Row, &c.Col, &c.Count, &c.Lon, &c.Lat, &c.TrustScore,
+			&c.RewardMultiplier, &c.RewardPoints, &c.Emergencies, &c.ClaimID, &c.NodeID); err != nil {
+			return nil, err
+		}
+		c.Lon /= c.Count
+		c.Lat /= c.Count
+		cells = append(cells, c)
+	}
+	return cells, rows.Err()
+}
+
+// NodeStats are the registration of a node and statistics of its claims.
+type NodeStats struct {
+	Node
+	Claims       int64
+	AvgScore     float64
+	RewardPoints int64
+	LastClaim    time.Time
+	// Paid is the total of the vested rewards paid to the node.
+	Paid sdk.Coins
+}
+
+// Nodes returns the statistics of the nodes with the most claims first. When
+// nodeID is set, only that node is returned.
+func (s *Store) Nodes(ctx context.Context, nodeID string, limit int) ([]NodeStats, error) {
+	query := `SELECT n.node_id, n.trust_tier, n.security_level, n.bond, n.registered_height, n.registered_time,
+		COUNT(c.id), COALESCE(AVG(c.trust_score), 0), COALESCE(SUM(c.reward_points), 0), COALESCE(MAX(c.block_time), 0)
+		FROM nodes n LEFT JOIN claims c ON c.node_id = n.node_id`
+	var args []any
+	if nodeID != "" {
+		args = append(args, nodeID)
+		query += ` WHERE n.node_id = $1`
+	}
+	query += ` GROUP BY n.node_id, n.trust_tier, n.security_level, n.bond, n.registered_height, n.registered_time
+		ORDER BY COUNT(c.id) DESC, n.node_id`
+	if limit > 0 {
+		args = append(args, limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var nodes []NodeStats
+	index := make(map[string]int)
+	for rows.Next() {
+		var n NodeStats
+		var registered, last int64
+		if err := rows.Scan(&n.ID, &n.TrustTier, &n.SecurityLevel, &n.Bond, &n.Height, &registered,
+			&n.Claims, &n.AvgScore, &n.RewardPoints, &last); err != nil {
+			return nil, err
+		}
+		if registered > 0 {
+			n.Time = time.Unix(registered, 0).UTC()
+		}
+		if last > 0 {
+			n.LastClaim = time.Unix(last, 0).UTC()
+		}
+		index[n.ID] = len(nodes)
+		nodes = append(nodes, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+
+	// 금액은 정수 범위를 넘을 수 있으므로 SQL 대신 math.Int로 합산
+	rewardsQuery := `SELECT node_id, denom, amount FROM rewards`
+	var rewardArgs []any
+	if nodeID != "" {
+		rewardArgs = append(rewardArgs, nodeID)
+		rewardsQuery += ` WHERE node_id = $1`
+	}
+	rewards, err := s.db.QueryContext(ctx, rewardsQuery, rewardArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rewards.Close()
+	for rewards.Next() {
+		var node, denom, amount string
+		if err := rewards.Scan(&node, &denom, &amount); err != nil {
+			return nil, err
+		}
+		i, ok := index[node]
+		if !ok {
+			continue
+		}
+		value, ok := math.NewIntFromString(amount)
+		if !ok {
+			return nil, fmt.Errorf("invalid reward amount %q of %s", amount, node)
+		}
+		nodes[i].Paid = nodes[i].Paid.Add(sdk.NewCoin(denom, value))
+	}
+	return nodes, rewards.Err()
+}
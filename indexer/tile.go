@@ -0,0 +1,61 @@
+package indexer
+
+import (
+	"fmt"
+	"math"
+)
+
+// MaxZoom is the deepest zoom level served.
+const MaxZoom = 22
+
+// maxLatitude is the latitude limit of the Web Mercator projection.
+const maxLatitude = 85.05112878
+
+// Tile is a Web Mercator map tile in the XYZ scheme.
+type Tile struct {
+	Z, X, Y int
+}
+
+// Validate checks that the tile exists at its zoom level.
+func (t Tile) Validate() error {
+	if t.Z < 0 || t.Z > MaxZoom {
+		return fmt.Errorf("zoom must be between 0 and %d: %d", MaxZoom, t.Z)
+	}
+	n := 1 << t.Z
+	if t.X < 0 || t.X >= n || t.Y < 0 || t.Y >= n {
+		return fmt.Errorf("tile %d/%d/%d does not exist", t.Z, t.X, t.Y)
+	}
+	return nil
+}
+
+// BBox returns the bounding box of the tile, rounded outwards to whole
+// microdegrees.
+func (t Tile) BBox() BBox {
+	n := float64(int(1) << t.Z)
+	lon := func(x int) float64 { return float64(x)/n*360 - 180 }
+	return BBox{
+		MinLon: int64(math.Floor(lon(t.X) * 1e6)),
+		MinLat: int64(math.Floor(t.latitude(float64(t.Y+1)) * 1e6)),
+		MaxLon: int64(math.Ceil(lon(t.X+1) * 1e6)),
+		MaxLat: int64(math.Ceil(t.latitude(float64(t.Y)) * 1e6)),
+	}
+}
+
+// latitude returns the latitude in degrees of the row y of tiles, fractional
+// rows included, at the zoom level of the tile.
+func (t Tile) latitude(y float64) float64 {
+	n := float64(int(1) << t.Z)
+	return math.Atan(math.Sinh(math.Pi*(1-2*y/n))) * 180 / math.Pi
+}
+
+// Project returns the position of a point in the tile, (0, 0) being its top
+// left corner and (1, 1) its bottom right corner. Points outside the tile are
+// outside of [0, 1).
+func (t Tile) Project(lon, lat float64) (px, py float64) {
+	n := float64(int(1) << t.Z)
+	lat = math.Max(-maxLatitude, math.Min(maxLatitude, lat))
+	rad := lat * math.Pi / 180
+	px = (lon+180)/360*n - float64(t.X)
+	py = (1-math.Log(math.Tan(rad)+1/math.Cos(rad))/math.Pi)/2*n - float64(t.Y)
+	return px, py
+}
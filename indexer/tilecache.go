@@ -0,0 +1,99 @@
+package indexer
+
+import (
+	"container/list"
+	"sync"
+)
+
+// DefaultTileCacheSize is the number of encoded vector tiles kept in memory.
+const DefaultTileCacheSize = 4096
+
+// tileCache keeps the most recently served tiles of the last indexed height.
+// Indexed claims never change, so a new block only invalidates the tiles
+// whose bounding box contains one of its claims.
+type tileCache struct {
+	mu      sync.Mutex
+	size    int
+	height  int64
+	order   *list.List // 최근 사용 순서, 앞쪽이 가장 최근
+	entries map[string]*list.Element
+}
+
+type tileCacheEntry struct {
+	key  string
+	bbox BBox
+	data []byte
+}
+
+func newTileCache(size int) *tileCache {
+	return &tileCache{size: size, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+// advance moves the cache up to height, dropping the tiles that contain a
+// claim indexed since the cached height. indexed returns the locations of the
+// claims indexed in the blocks above from up to to.
+func (c *tileCache) advance(height int64, indexed func(from, to int64) ([]Location, error)) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if height <= c.height {
+		return nil
+	}
+	// 캐시가 비어 있으면 새 클레임을 조회할 필요가 없음
+	if c.order.Len() > 0 {
+		locations, err := indexed(c.height, height)
+		if err != nil {
+			return err
+		}
+		for e := c.order.Front(); e != nil; {
+			next := e.Next()
+			entry := e.Value.(*tileCacheEntry)
+			for _, l := range locations {
+				if entry.bbox.Contains(l) {
+					c.order.Remove(e)
+					delete(c.entries, entry.key)
+					break
+				}
+			}
+			e = next
+		}
+	}
+	c.height = height
+	return nil
+}
+
+// get returns the tile cached under key at height.
+func (c *tileCache) get(height int64, key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// 다른 높이에서 시작된 요청에는 캐시를 사용하지 않음
+	if height != c.height {
+		return nil, false
+	}
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*tileCacheEntry).data, true
+}
+
+// add caches the tile key covering bbox encoded at height, evicting the least
+// recently used tile when the cache is full.
+func (c *tileCache) add(height int64, key string, bbox BBox, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if height != c.height {
+		return
+	}
+	if e, ok := c.entries[key]; ok {
+		e.Value.(*tileCacheEntry).data = data
+		c.order.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&tileCacheEntry{key: key, bbox: bbox, data: data})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*tileCacheEntry).key)
+	}
+}
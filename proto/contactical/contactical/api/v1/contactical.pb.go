@@ -21,6 +21,162 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 제출 처리 상태
+type SubmissionStatus int32
+
+const (
+	SubmissionStatus_SUBMISSION_STATUS_UNSPECIFIED SubmissionStatus = 0
+	SubmissionStatus_SUBMISSION_STATUS_PENDING     SubmissionStatus = 1 // 배치 대기열에서 전송 대기 중
+	SubmissionStatus_SUBMISSION_STATUS_BROADCAST   SubmissionStatus = 2 // 트랜잭션 전송됨 (블록 포함 대기)
+	SubmissionStatus_SUBMISSION_STATUS_COMMITTED   SubmissionStatus = 3 // 블록에 포함되어 클레임 생성됨
+	SubmissionStatus_SUBMISSION_STATUS_FAILED      SubmissionStatus = 4 // 재시도 후에도 실패
+	SubmissionStatus_SUBMISSION_STATUS_REJECTED    SubmissionStatus = 5 // 게이트웨이 검증 실패로 접수 거부
+)
+
+// Enum value maps for SubmissionStatus.
+var (
+	SubmissionStatus_name = map[int32]string{
+		0: "SUBMISSION_STATUS_UNSPECIFIED",
+		1: "SUBMISSION_STATUS_PENDING",
+		2: "SUBMISSION_STATUS_BROADCAST",
+		3: "SUBMISSION_STATUS_COMMITTED",
+		4: "SUBMISSION_STATUS_FAILED",
+		5: "SUBMISSION_STATUS_REJECTED",
+	}
+	SubmissionStatus_value = map[string]int32{
+		"SUBMISSION_STATUS_UNSPECIFIED": 0,
+		"SUBMISSION_STATUS_PENDING":     1,
+		"SUBMISSION_STATUS_BROADCAST":   2,
+		"SUBMISSION_STATUS_COMMITTED":   3,
+		"SUBMISSION_STATUS_FAILED":      4,
+		"SUBMISSION_STATUS_REJECTED":    5,
+	}
+)
+
+func (x SubmissionStatus) Enum() *SubmissionStatus {
+	p := new(SubmissionStatus)
+	*p = x
+	return p
+}
+
+func (x SubmissionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubmissionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_contactical_proto_enumTypes[0].Descriptor()
+}
+
+func (SubmissionStatus) Type() protoreflect.EnumType {
+	return &file_contactical_proto_enumTypes[0]
+}
+
+func (x SubmissionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubmissionStatus.Descriptor instead.
+func (SubmissionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_contactical_proto_rawDescGZIP(), []int{0}
+}
+
+// 챌린지 발급 요청
+type GetChallengeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CreatorAddress string                 `protobuf:"bytes,1,opt,name=creator_address,json=creatorAddress,proto3" json:"creator_address,omitempty"` // 챌린지를 사용해 등록할 지갑 주소
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetChallengeRequest) Reset() {
+	*x = GetChallengeRequest{}
+	mi := &file_contactical_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeRequest) ProtoMessage() {}
+
+func (x *GetChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contactical_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeRequest.ProtoReflect.Descriptor instead.
+func (*GetChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_contactical_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetChallengeRequest) GetCreatorAddress() string {
+	if x != nil {
+		return x.CreatorAddress
+	}
+	return ""
+}
+
+type GetChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Challenge     string                 `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`                   // Key Attestation에 넣을 챌린지 값 (Base64)
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 만료 시각 (Unix 초)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChallengeResponse) Reset() {
+	*x = GetChallengeResponse{}
+	mi := &file_contactical_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeResponse) ProtoMessage() {}
+
+func (x *GetChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contactical_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeResponse.ProtoReflect.Descriptor instead.
+func (*GetChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_contactical_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetChallengeResponse) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *GetChallengeResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
 // 노드 등록 요청
 type RegisterNodeRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -33,7 +189,7 @@ type RegisterNodeRequest struct {
 
 func (x *RegisterNodeRequest) Reset() {
 	*x = RegisterNodeRequest{}
-	mi := &file_contactical_proto_msgTypes[0]
+	mi := &file_contactical_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -45,7 +201,7 @@ func (x *RegisterNodeRequest) String() string {
 func (*RegisterNodeRequest) ProtoMessage() {}
 
 func (x *RegisterNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contactical_proto_msgTypes[0]
+	mi := &file_contactical_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,7 +214,7 @@ func (x *RegisterNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterNodeRequest.ProtoReflect.Descriptor instead.
 func (*RegisterNodeRequest) Descriptor() ([]byte, []int) {
-	return file_contactical_proto_rawDescGZIP(), []int{0}
+	return file_contactical_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *RegisterNodeRequest) GetCreatorAddress() string {
@@ -93,7 +249,7 @@ type RegisterNodeResponse struct {
 
 func (x *RegisterNodeResponse) Reset() {
 	*x = RegisterNodeResponse{}
-	mi := &file_contactical_proto_msgTypes[1]
+	mi := &file_contactical_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -105,7 +261,7 @@ func (x *RegisterNodeResponse) String() string {
 func (*RegisterNodeResponse) ProtoMessage() {}
 
 func (x *RegisterNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contactical_proto_msgTypes[1]
+	mi := &file_contactical_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -118,7 +274,7 @@ func (x *RegisterNodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterNodeResponse.ProtoReflect.Descriptor instead.
 func (*RegisterNodeResponse) Descriptor() ([]byte, []int) {
-	return file_contactical_proto_rawDescGZIP(), []int{1}
+	return file_contactical_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RegisterNodeResponse) GetSuccess() bool {
@@ -155,7 +311,7 @@ type SubmitDataRequest struct {
 
 func (x *SubmitDataRequest) Reset() {
 	*x = SubmitDataRequest{}
-	mi := &file_contactical_proto_msgTypes[2]
+	mi := &file_contactical_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -167,7 +323,7 @@ func (x *SubmitDataRequest) String() string {
 func (*SubmitDataRequest) ProtoMessage() {}
 
 func (x *SubmitDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contactical_proto_msgTypes[2]
+	mi := &file_contactical_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -180,7 +336,7 @@ func (x *SubmitDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitDataRequest.ProtoReflect.Descriptor instead.
 func (*SubmitDataRequest) Descriptor() ([]byte, []int) {
-	return file_contactical_proto_rawDescGZIP(), []int{2}
+	return file_contactical_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SubmitDataRequest) GetNodeId() string {
@@ -214,14 +370,15 @@ func (x *SubmitDataRequest) GetCert() string {
 type SubmitDataResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	TxHash        string                 `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"` // 블록체인에 기록된 트랜잭션 해시
+	TxHash        string                 `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                   // 블록체인에 기록된 트랜잭션 해시 (배치 전송 전에는 비어 있음)
+	SubmissionId  string                 `protobuf:"bytes,3,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"` // 처리 상태 조회용 제출 ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubmitDataResponse) Reset() {
 	*x = SubmitDataResponse{}
-	mi := &file_contactical_proto_msgTypes[3]
+	mi := &file_contactical_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -233,7 +390,7 @@ func (x *SubmitDataResponse) String() string {
 func (*SubmitDataResponse) ProtoMessage() {}
 
 func (x *SubmitDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contactical_proto_msgTypes[3]
+	mi := &file_contactical_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,7 +403,7 @@ func (x *SubmitDataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitDataResponse.ProtoReflect.Descriptor instead.
 func (*SubmitDataResponse) Descriptor() ([]byte, []int) {
-	return file_contactical_proto_rawDescGZIP(), []int{3}
+	return file_contactical_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SubmitDataResponse) GetSuccess() bool {
@@ -263,11 +420,331 @@ func (x *SubmitDataResponse) GetTxHash() string {
 	return ""
 }
 
+func (x *SubmitDataResponse) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+// 제출 상태 조회 요청
+type GetSubmissionStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubmissionStatusRequest) Reset() {
+	*x = GetSubmissionStatusRequest{}
+	mi := &file_contactical_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubmissionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubmissionStatusRequest) ProtoMessage() {}
+
+func (x *GetSubmissionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contactical_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubmissionStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSubmissionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_contactical_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetSubmissionStatusRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+type GetSubmissionStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	Status        SubmissionStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=contactical.v1.SubmissionStatus" json:"status,omitempty"`
+	TxHash        string                 `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"` // 클레임이 포함된 트랜잭션 해시
+	Height        int64                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`              // 트랜잭션이 포함된 블록 높이
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`                 // 실패 사유
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubmissionStatusResponse) Reset() {
+	*x = GetSubmissionStatusResponse{}
+	mi := &file_contactical_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubmissionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubmissionStatusResponse) ProtoMessage() {}
+
+func (x *GetSubmissionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contactical_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubmissionStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSubmissionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_contactical_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetSubmissionStatusResponse) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *GetSubmissionStatusResponse) GetStatus() SubmissionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubmissionStatus_SUBMISSION_STATUS_UNSPECIFIED
+}
+
+func (x *GetSubmissionStatusResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *GetSubmissionStatusResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetSubmissionStatusResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// 스트림 제출 요청
+type StreamSubmitDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`       // 클라이언트가 정한 요청 ID (응답에 그대로 반환)
+	Reading       *SubmitDataRequest     `protobuf:"bytes,2,opt,name=reading,proto3" json:"reading,omitempty"`                            // 제출할 데이터 (재연결만 할 때는 비워둠)
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // 재연결 시 마지막으로 받은 응답의 토큰 (첫 메시지에만 사용)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamSubmitDataRequest) Reset() {
+	*x = StreamSubmitDataRequest{}
+	mi := &file_contactical_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSubmitDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSubmitDataRequest) ProtoMessage() {}
+
+func (x *StreamSubmitDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contactical_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSubmitDataRequest.ProtoReflect.Descriptor instead.
+func (*StreamSubmitDataRequest) Descriptor() ([]byte, []int) {
+	return file_contactical_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StreamSubmitDataRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *StreamSubmitDataRequest) GetReading() *SubmitDataRequest {
+	if x != nil {
+		return x.Reading
+	}
+	return nil
+}
+
+func (x *StreamSubmitDataRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// 스트림 처리 결과 (제출 하나당 상태가 바뀔 때마다 전달)
+type StreamSubmitDataResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RequestId        string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SubmissionId     string                 `protobuf:"bytes,2,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	Status           SubmissionStatus       `protobuf:"varint,3,opt,name=status,proto3,enum=contactical.v1.SubmissionStatus" json:"status,omitempty"`
+	Error            string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"` // 거부/실패 사유
+	TxHash           string                 `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Height           int64                  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	ClaimId          uint64                 `protobuf:"varint,7,opt,name=claim_id,json=claimId,proto3" json:"claim_id,omitempty"`          // 생성된 클레임 ID
+	TrustScore       int64                  `protobuf:"varint,8,opt,name=trust_score,json=trustScore,proto3" json:"trust_score,omitempty"` // 체인이 계산한 신뢰 점수
+	RewardMultiplier int64                  `protobuf:"varint,9,opt,name=reward_multiplier,json=rewardMultiplier,proto3" json:"reward_multiplier,omitempty"`
+	RewardPoints     int64                  `protobuf:"varint,10,opt,name=reward_points,json=rewardPoints,proto3" json:"reward_points,omitempty"` // 적립된 보상 포인트
+	ResumeToken      string                 `protobuf:"bytes,11,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`     // 재연결 시 이 응답 이후부터 다시 받기 위한 토큰
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StreamSubmitDataResponse) Reset() {
+	*x = StreamSubmitDataResponse{}
+	mi := &file_contactical_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSubmitDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSubmitDataResponse) ProtoMessage() {}
+
+func (x *StreamSubmitDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contactical_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSubmitDataResponse.ProtoReflect.Descriptor instead.
+func (*StreamSubmitDataResponse) Descriptor() ([]byte, []int) {
+	return file_contactical_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StreamSubmitDataResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *StreamSubmitDataResponse) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *StreamSubmitDataResponse) GetStatus() SubmissionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubmissionStatus_SUBMISSION_STATUS_UNSPECIFIED
+}
+
+func (x *StreamSubmitDataResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *StreamSubmitDataResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *StreamSubmitDataResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *StreamSubmitDataResponse) GetClaimId() uint64 {
+	if x != nil {
+		return x.ClaimId
+	}
+	return 0
+}
+
+func (x *StreamSubmitDataResponse) GetTrustScore() int64 {
+	if x != nil {
+		return x.TrustScore
+	}
+	return 0
+}
+
+func (x *StreamSubmitDataResponse) GetRewardMultiplier() int64 {
+	if x != nil {
+		return x.RewardMultiplier
+	}
+	return 0
+}
+
+func (x *StreamSubmitDataResponse) GetRewardPoints() int64 {
+	if x != nil {
+		return x.RewardPoints
+	}
+	return 0
+}
+
+func (x *StreamSubmitDataResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
 var File_contactical_proto protoreflect.FileDescriptor
 
 const file_contactical_proto_rawDesc = "" +
 	"\n" +
-	"\x11contactical.proto\x12\x0econtactical.v1\"{\n" +
+	"\x11contactical.proto\x12\x0econtactical.v1\">\n" +
+	"\x13GetChallengeRequest\x12'\n" +
+	"\x0fcreator_address\x18\x01 \x01(\tR\x0ecreatorAddress\"S\n" +
+	"\x14GetChallengeResponse\x12\x1c\n" +
+	"\tchallenge\x18\x01 \x01(\tR\tchallenge\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\"{\n" +
 	"\x13RegisterNodeRequest\x12'\n" +
 	"\x0fcreator_address\x18\x01 \x01(\tR\x0ecreatorAddress\x12\x1d\n" +
 	"\n" +
@@ -281,14 +758,53 @@ const file_contactical_proto_rawDesc = "" +
 	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x18\n" +
 	"\apayload\x18\x02 \x01(\tR\apayload\x12\x1c\n" +
 	"\tsignature\x18\x03 \x01(\tR\tsignature\x12\x12\n" +
-	"\x04cert\x18\x04 \x01(\tR\x04cert\"G\n" +
+	"\x04cert\x18\x04 \x01(\tR\x04cert\"l\n" +
 	"\x12SubmitDataResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x17\n" +
-	"\atx_hash\x18\x02 \x01(\tR\x06txHash2\xc4\x01\n" +
+	"\atx_hash\x18\x02 \x01(\tR\x06txHash\x12#\n" +
+	"\rsubmission_id\x18\x03 \x01(\tR\fsubmissionId\"A\n" +
+	"\x1aGetSubmissionStatusRequest\x12#\n" +
+	"\rsubmission_id\x18\x01 \x01(\tR\fsubmissionId\"\xc3\x01\n" +
+	"\x1bGetSubmissionStatusResponse\x12#\n" +
+	"\rsubmission_id\x18\x01 \x01(\tR\fsubmissionId\x128\n" +
+	"\x06status\x18\x02 \x01(\x0e2 .contactical.v1.SubmissionStatusR\x06status\x12\x17\n" +
+	"\atx_hash\x18\x03 \x01(\tR\x06txHash\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x03R\x06height\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\x98\x01\n" +
+	"\x17StreamSubmitDataRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12;\n" +
+	"\areading\x18\x02 \x01(\v2!.contactical.v1.SubmitDataRequestR\areading\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\"\x90\x03\n" +
+	"\x18StreamSubmitDataResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12#\n" +
+	"\rsubmission_id\x18\x02 \x01(\tR\fsubmissionId\x128\n" +
+	"\x06status\x18\x03 \x01(\x0e2 .contactical.v1.SubmissionStatusR\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x17\n" +
+	"\atx_hash\x18\x05 \x01(\tR\x06txHash\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x03R\x06height\x12\x19\n" +
+	"\bclaim_id\x18\a \x01(\x04R\aclaimId\x12\x1f\n" +
+	"\vtrust_score\x18\b \x01(\x03R\n" +
+	"trustScore\x12+\n" +
+	"\x11reward_multiplier\x18\t \x01(\x03R\x10rewardMultiplier\x12#\n" +
+	"\rreward_points\x18\n" +
+	" \x01(\x03R\frewardPoints\x12!\n" +
+	"\fresume_token\x18\v \x01(\tR\vresumeToken*\xd4\x01\n" +
+	"\x10SubmissionStatus\x12!\n" +
+	"\x1dSUBMISSION_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19SUBMISSION_STATUS_PENDING\x10\x01\x12\x1f\n" +
+	"\x1bSUBMISSION_STATUS_BROADCAST\x10\x02\x12\x1f\n" +
+	"\x1bSUBMISSION_STATUS_COMMITTED\x10\x03\x12\x1c\n" +
+	"\x18SUBMISSION_STATUS_FAILED\x10\x04\x12\x1e\n" +
+	"\x1aSUBMISSION_STATUS_REJECTED\x10\x052\xfa\x03\n" +
 	"\x12ContacticalService\x12Y\n" +
+	"\fGetChallenge\x12#.contactical.v1.GetChallengeRequest\x1a$.contactical.v1.GetChallengeResponse\x12Y\n" +
 	"\fRegisterNode\x12#.contactical.v1.RegisterNodeRequest\x1a$.contactical.v1.RegisterNodeResponse\x12S\n" +
 	"\n" +
-	"SubmitData\x12!.contactical.v1.SubmitDataRequest\x1a\".contactical.v1.SubmitDataResponseB\x14Z\x12contactical/api/v1b\x06proto3"
+	"SubmitData\x12!.contactical.v1.SubmitDataRequest\x1a\".contactical.v1.SubmitDataResponse\x12n\n" +
+	"\x13GetSubmissionStatus\x12*.contactical.v1.GetSubmissionStatusRequest\x1a+.contactical.v1.GetSubmissionStatusResponse\x12i\n" +
+	"\x10StreamSubmitData\x12'.contactical.v1.StreamSubmitDataRequest\x1a(.contactical.v1.StreamSubmitDataResponse(\x010\x01B\x14Z\x12contactical/api/v1b\x06proto3"
 
 var (
 	file_contactical_proto_rawDescOnce sync.Once
@@ -302,23 +818,40 @@ func file_contactical_proto_rawDescGZIP() []byte {
 	return file_contactical_proto_rawDescData
 }
 
-var file_contactical_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_contactical_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_contactical_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_contactical_proto_goTypes = []any{
-	(*RegisterNodeRequest)(nil),  // 0: contactical.v1.RegisterNodeRequest
-	(*RegisterNodeResponse)(nil), // 1: contactical.v1.RegisterNodeResponse
-	(*SubmitDataRequest)(nil),    // 2: contactical.v1.SubmitDataRequest
-	(*SubmitDataResponse)(nil),   // 3: contactical.v1.SubmitDataResponse
+	(SubmissionStatus)(0),               // 0: contactical.v1.SubmissionStatus
+	(*GetChallengeRequest)(nil),         // 1: contactical.v1.GetChallengeRequest
+	(*GetChallengeResponse)(nil),        // 2: contactical.v1.GetChallengeResponse
+	(*RegisterNodeRequest)(nil),         // 3: contactical.v1.RegisterNodeRequest
+	(*RegisterNodeResponse)(nil),        // 4: contactical.v1.RegisterNodeResponse
+	(*SubmitDataRequest)(nil),           // 5: contactical.v1.SubmitDataRequest
+	(*SubmitDataResponse)(nil),          // 6: contactical.v1.SubmitDataResponse
+	(*GetSubmissionStatusRequest)(nil),  // 7: contactical.v1.GetSubmissionStatusRequest
+	(*GetSubmissionStatusResponse)(nil), // 8: contactical.v1.GetSubmissionStatusResponse
+	(*StreamSubmitDataRequest)(nil),     // 9: contactical.v1.StreamSubmitDataRequest
+	(*StreamSubmitDataResponse)(nil),    // 10: contactical.v1.StreamSubmitDataResponse
 }
 var file_contactical_proto_depIdxs = []int32{
-	0, // 0: contactical.v1.ContacticalService.RegisterNode:input_type -> contactical.v1.RegisterNodeRequest
-	2, // 1: contactical.v1.ContacticalService.SubmitData:input_type -> contactical.v1.SubmitDataRequest
-	1, // 2: contactical.v1.ContacticalService.RegisterNode:output_type -> contactical.v1.RegisterNodeResponse
-	3, // 3: contactical.v1.ContacticalService.SubmitData:output_type -> contactical.v1.SubmitDataResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: contactical.v1.GetSubmissionStatusResponse.status:type_name -> contactical.v1.SubmissionStatus
+	5,  // 1: contactical.v1.StreamSubmitDataRequest.reading:type_name -> contactical.v1.SubmitDataRequest
+	0,  // 2: contactical.v1.StreamSubmitDataResponse.status:type_name -> contactical.v1.SubmissionStatus
+	1,  // 3: contactical.v1.ContacticalService.GetChallenge:input_type -> contactical.v1.GetChallengeRequest
+	3,  // 4: contactical.v1.ContacticalService.RegisterNode:input_type -> contactical.v1.RegisterNodeRequest
+	5,  // 5: contactical.v1.ContacticalService.SubmitData:input_type -> contactical.v1.SubmitDataRequest
+	7,  // 6: contactical.v1.ContacticalService.GetSubmissionStatus:input_type -> contactical.v1.GetSubmissionStatusRequest
+	9,  // 7: contactical.v1.ContacticalService.StreamSubmitData:input_type -> contactical.v1.StreamSubmitDataRequest
+	2,  // 8: contactical.v1.ContacticalService.GetChallenge:output_type -> contactical.v1.GetChallengeResponse
+	4,  // 9: contactical.v1.ContacticalService.RegisterNode:output_type -> contactical.v1.RegisterNodeResponse
+	6,  // 10: contactical.v1.ContacticalService.SubmitData:output_type -> contactical.v1.SubmitDataResponse
+	8,  // 11: contactical.v1.ContacticalService.GetSubmissionStatus:output_type -> contactical.v1.GetSubmissionStatusResponse
+	10, // 12: contactical.v1.ContacticalService.StreamSubmitData:output_type -> contactical.v1.StreamSubmitDataResponse
+	8,  // [8:13] is the sub-list for method output_type
+	3,  // [3:8] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_contactical_proto_init() }
@@ -331,13 +864,14 @@ func file_contactical_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_contactical_proto_rawDesc), len(file_contactical_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_contactical_proto_goTypes,
 		DependencyIndexes: file_contactical_proto_depIdxs,
+		EnumInfos:         file_contactical_proto_enumTypes,
 		MessageInfos:      file_contactical_proto_msgTypes,
 	}.Build()
 	File_contactical_proto = out.File
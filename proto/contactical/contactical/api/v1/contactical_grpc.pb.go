@@ -19,8 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ContacticalService_RegisterNode_FullMethodName = "/contactical.v1.ContacticalService/RegisterNode"
-	ContacticalService_SubmitData_FullMethodName   = "/contactical.v1.ContacticalService/SubmitData"
+	ContacticalService_GetChallenge_FullMethodName        = "/contactical.v1.ContacticalService/GetChallenge"
+	ContacticalService_RegisterNode_FullMethodName        = "/contactical.v1.ContacticalService/RegisterNode"
+	ContacticalService_SubmitData_FullMethodName          = "/contactical.v1.ContacticalService/SubmitData"
+	ContacticalService_GetSubmissionStatus_FullMethodName = "/contactical.v1.ContacticalService/GetSubmissionStatus"
+	ContacticalService_StreamSubmitData_FullMethodName    = "/contactical.v1.ContacticalService/StreamSubmitData"
 )
 
 // ContacticalServiceClient is the client API for ContacticalService service.
@@ -29,10 +32,16 @@ const (
 //
 // 1. 서비스 정의: 서버가 제공할 기능들의 목록
 type ContacticalServiceClient interface {
+	// 노드 등록에 사용할 일회용 챌린지 발급 (주소에 묶이고 짧은 유효기간)
+	GetChallenge(ctx context.Context, in *GetChallengeRequest, opts ...grpc.CallOption) (*GetChallengeResponse, error)
 	// 노드 등록 (Key Attestation 검증 포함)
 	RegisterNode(ctx context.Context, in *RegisterNodeRequest, opts ...grpc.CallOption) (*RegisterNodeResponse, error)
-	// 센서 데이터 제출 (TEE 서명 검증 포함)
+	// 센서 데이터 제출 (TEE 서명 검증 포함, 배치 트랜잭션으로 묶어 전송)
 	SubmitData(ctx context.Context, in *SubmitDataRequest, opts ...grpc.CallOption) (*SubmitDataResponse, error)
+	// 제출된 데이터의 처리 상태 조회
+	GetSubmissionStatus(ctx context.Context, in *GetSubmissionStatusRequest, opts ...grpc.CallOption) (*GetSubmissionStatusResponse, error)
+	// 하나의 연결로 데이터를 연속 제출하고 체인 처리 결과(접수/거부/점수/보상)를 받는 양방향 스트림
+	StreamSubmitData(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamSubmitDataRequest, StreamSubmitDataResponse], error)
 }
 
 type contacticalServiceClient struct {
@@ -43,6 +52,16 @@ func NewContacticalServiceClient(cc grpc.ClientConnInterface) ContacticalService
 	return &contacticalServiceClient{cc}
 }
 
+func (c *contacticalServiceClient) GetChallenge(ctx context.Context, in *GetChallengeRequest, opts ...grpc.CallOption) (*GetChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChallengeResponse)
+	err := c.cc.Invoke(ctx, ContacticalService_GetChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *contacticalServiceClient) RegisterNode(ctx context.Context, in *RegisterNodeRequest, opts ...grpc.CallOption) (*RegisterNodeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RegisterNodeResponse)
@@ -63,16 +82,45 @@ func (c *contacticalServiceClient) SubmitData(ctx context.Context, in *SubmitDat
 	return out, nil
 }
 
+func (c *contacticalServiceClient) GetSubmissionStatus(ctx context.Context, in *GetSubmissionStatusRequest, opts ...grpc.CallOption) (*GetSubmissionStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSubmissionStatusResponse)
+	err := c.cc.Invoke(ctx, ContacticalService_GetSubmissionStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contacticalServiceClient) StreamSubmitData(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamSubmitDataRequest, StreamSubmitDataResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ContacticalService_ServiceDesc.Streams[0], ContacticalService_StreamSubmitData_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamSubmitDataRequest, StreamSubmitDataResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContacticalService_StreamSubmitDataClient = grpc.BidiStreamingClient[StreamSubmitDataRequest, StreamSubmitDataResponse]
+
 // ContacticalServiceServer is the server API for ContacticalService service.
 // All implementations must embed UnimplementedContacticalServiceServer
 // for forward compatibility.
 //
 // 1. 서비스 정의: 서버가 제공할 기능들의 목록
 type ContacticalServiceServer interface {
+	// 노드 등록에 사용할 일회용 챌린지 발급 (주소에 묶이고 짧은 유효기간)
+	GetChallenge(context.Context, *GetChallengeRequest) (*GetChallengeResponse, error)
 	// 노드 등록 (Key Attestation 검증 포함)
 	RegisterNode(context.Context, *RegisterNodeRequest) (*RegisterNodeResponse, error)
-	// 센서 데이터 제출 (TEE 서명 검증 포함)
+	// 센서 데이터 제출 (TEE 서명 검증 포함, 배치 트랜잭션으로 묶어 전송)
 	SubmitData(context.Context, *SubmitDataRequest) (*SubmitDataResponse, error)
+	// 제출된 데이터의 처리 상태 조회
+	GetSubmissionStatus(context.Context, *GetSubmissionStatusRequest) (*GetSubmissionStatusResponse, error)
+	// 하나의 연결로 데이터를 연속 제출하고 체인 처리 결과(접수/거부/점수/보상)를 받는 양방향 스트림
+	StreamSubmitData(grpc.BidiStreamingServer[StreamSubmitDataRequest, StreamSubmitDataResponse]) error
 	mustEmbedUnimplementedContacticalServiceServer()
 }
 
@@ -83,12 +131,21 @@ type ContacticalServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedContacticalServiceServer struct{}
 
+func (UnimplementedContacticalServiceServer) GetChallenge(context.Context, *GetChallengeRequest) (*GetChallengeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChallenge not implemented")
+}
 func (UnimplementedContacticalServiceServer) RegisterNode(context.Context, *RegisterNodeRequest) (*RegisterNodeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RegisterNode not implemented")
 }
 func (UnimplementedContacticalServiceServer) SubmitData(context.Context, *SubmitDataRequest) (*SubmitDataResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SubmitData not implemented")
 }
+func (UnimplementedContacticalServiceServer) GetSubmissionStatus(context.Context, *GetSubmissionStatusRequest) (*GetSubmissionStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSubmissionStatus not implemented")
+}
+func (UnimplementedContacticalServiceServer) StreamSubmitData(grpc.BidiStreamingServer[StreamSubmitDataRequest, StreamSubmitDataResponse]) error {
+	return status.Error(codes.Unimplemented, "method StreamSubmitData not implemented")
+}
 func (UnimplementedContacticalServiceServer) mustEmbedUnimplementedContacticalServiceServer() {}
 func (UnimplementedContacticalServiceServer) testEmbeddedByValue()                            {}
 
@@ -110,6 +167,24 @@ func RegisterContacticalServiceServer(s grpc.ServiceRegistrar, srv ContacticalSe
 	s.RegisterService(&ContacticalService_ServiceDesc, srv)
 }
 
+func _ContacticalService_GetChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContacticalServiceServer).GetChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContacticalService_GetChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContacticalServiceServer).GetChallenge(ctx, req.(*GetChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ContacticalService_RegisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterNodeRequest)
 	if err := dec(in); err != nil {
@@ -146,6 +221,31 @@ func _ContacticalService_SubmitData_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContacticalService_GetSubmissionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubmissionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContacticalServiceServer).GetSubmissionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContacticalService_GetSubmissionStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContacticalServiceServer).GetSubmissionStatus(ctx, req.(*GetSubmissionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContacticalService_StreamSubmitData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ContacticalServiceServer).StreamSubmitData(&grpc.GenericServerStream[StreamSubmitDataRequest, StreamSubmitDataResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContacticalService_StreamSubmitDataServer = grpc.BidiStreamingServer[StreamSubmitDataRequest, StreamSubmitDataResponse]
+
 // ContacticalService_ServiceDesc is the grpc.ServiceDesc for ContacticalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +253,10 @@ var ContacticalService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "contactical.v1.ContacticalService",
 	HandlerType: (*ContacticalServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetChallenge",
+			Handler:    _ContacticalService_GetChallenge_Handler,
+		},
 		{
 			MethodName: "RegisterNode",
 			Handler:    _ContacticalService_RegisterNode_Handler,
@@ -161,7 +265,18 @@ var ContacticalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitData",
 			Handler:    _ContacticalService_SubmitData_Handler,
 		},
+		{
+			MethodName: "GetSubmissionStatus",
+			Handler:    _ContacticalService_GetSubmissionStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSubmitData",
+			Handler:       _ContacticalService_StreamSubmitData_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "contactical.proto",
 }
@@ -0,0 +1,73 @@
+package network
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"contactical/app"
+)
+
+type (
+	Network = network.Network
+	Config  = network.Config
+)
+
+// New creates an in-process network of the app and waits for its first
+// block. Accepts an optional config used in place of DefaultConfig().
+func New(t *testing.T, configs ...Config) *Network {
+	t.Helper()
+	if len(configs) > 1 {
+		panic("at most one config should be provided")
+	}
+	var cfg network.Config
+	if len(configs) == 0 {
+		cfg = DefaultConfig()
+	} else {
+		cfg = configs[0]
+	}
+	net, err := network.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	_, err = net.WaitForHeight(1)
+	require.NoError(t, err)
+	t.Cleanup(net.Cleanup)
+	return net
+}
+
+// DefaultConfig returns a single validator network config running the full
+// app with its default genesis. All other parameters are inherited from the
+// cosmos-sdk network.DefaultConfig.
+func DefaultConfig() network.Config {
+	cfg := network.DefaultConfig(func() network.TestFixture {
+		tempApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+		return network.TestFixture{
+			AppConstructor: func(val network.ValidatorI) servertypes.Application {
+				return app.New(
+					val.GetCtx().Logger, dbm.NewMemDB(), nil, true,
+					simtestutil.NewAppOptionsWithFlagHome(val.GetCtx().Config.RootDir),
+					baseapp.SetPruning(pruningtypes.NewPruningOptionsFromString(val.GetAppConfig().Pruning)),
+					baseapp.SetMinGasPrices(val.GetAppConfig().MinGasPrices),
+					baseapp.SetChainID(val.GetCtx().Viper.GetString(flags.FlagChainID)),
+				)
+			},
+			GenesisState: tempApp.DefaultGenesis(),
+			EncodingConfig: moduletestutil.TestEncodingConfig{
+				InterfaceRegistry: tempApp.InterfaceRegistry(),
+				Codec:             tempApp.AppCodec(),
+				TxConfig:          tempApp.TxConfig(),
+				Amino:             tempApp.LegacyAmino(),
+			},
+		}
+	})
+	cfg.NumValidators = 1
+	return cfg
+}
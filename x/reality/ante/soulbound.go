@@ -0,0 +1,101 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"contactical/x/reality/types"
+)
+
+// SoulboundDecorator rejects x/nft transfers of device identity tokens,
+// including those wrapped in authz.MsgExec. Device NFTs are bound to the node
+// that registered; they can only be burned by the reality module on retire or
+// ban.
+//
+// Being an ante decorator, it only sees the messages of submitted
+// transactions. Messages dispatched by modules, like the proposals executed by
+// x/gov and x/group, are checked by SoulboundRouter.
+type SoulboundDecorator struct{}
+
+// NewSoulboundDecorator returns a new SoulboundDecorator.
+func NewSoulboundDecorator() SoulboundDecorator {
+	return SoulboundDecorator{}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d SoulboundDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := rejectSoulboundTransfers(tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+func rejectSoulboundTransfers(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *nft.MsgSend:
+			if m.ClassId == types.DeviceClassId {
+				return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "device identity nft %s is soulbound", m.Id)
+			}
+		case *authz.MsgExec:
+			inner, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := rejectSoulboundTransfers(inner); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WrapAnteHandler runs the SoulboundDecorator in front of an existing ante handler.
+func WrapAnteHandler(next sdk.AnteHandler) sdk.AnteHandler {
+	if next == nil {
+		next = func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	}
+	decorator := NewSoulboundDecorator()
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return decorator.AnteHandle(ctx, tx, simulate, next)
+	}
+}
+
+// SoulboundRouter wraps a message router to reject x/nft transfers of device
+// identity tokens among the messages dispatched through it. It is given to the
+// modules executing messages on behalf of accounts, like x/gov, x/group, authz
+// and interchain accounts, whose messages skip the ante handler.
+type SoulboundRouter struct {
+	baseapp.MessageRouter
+}
+
+// NewSoulboundRouter returns a SoulboundRouter dispatching through router.
+func NewSoulboundRouter(router baseapp.MessageRouter) *SoulboundRouter {
+	return &SoulboundRouter{MessageRouter: router}
+}
+
+// Handler implements baseapp.MessageRouter.
+func (r *SoulboundRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	return soulboundHandler(r.MessageRouter.Handler(msg))
+}
+
+// HandlerByTypeURL implements baseapp.MessageRouter.
+func (r *SoulboundRouter) HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler {
+	return soulboundHandler(r.MessageRouter.HandlerByTypeURL(typeURL))
+}
+
+func soulboundHandler(handler baseapp.MsgServiceHandler) baseapp.MsgServiceHandler {
+	if handler == nil {
+		return nil
+	}
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if err := rejectSoulboundTransfers([]sdk.Msg{msg}); err != nil {
+			return nil, err
+		}
+		return handler(ctx, msg)
+	}
+}
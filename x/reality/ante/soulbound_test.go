@@ -0,0 +1,68 @@
+package ante_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/x/nft"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"contactical/x/reality/ante"
+	"contactical/x/reality/types"
+)
+
+// mockTx is a transaction carrying only messages.
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx mockTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func TestSoulboundDecorator(t *testing.T) {
+	node := sdk.AccAddress([]byte("node________________"))
+	receiver := sdk.AccAddress([]byte("receiver____________"))
+	send := func(classID string) sdk.Msg {
+		return &nft.MsgSend{ClassId: classID, Id: "device-1", Sender: node.String(), Receiver: receiver.String()}
+	}
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(receiver, msgs)
+		return &msg
+	}
+	bankSend := banktypes.NewMsgSend(node, receiver, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	tests := []struct {
+		desc     string
+		msgs     []sdk.Msg
+		rejected bool
+	}{
+		{desc: "device nft send", msgs: []sdk.Msg{send(types.DeviceClassId)}, rejected: true},
+		{desc: "device nft send after another message", msgs: []sdk.Msg{bankSend, send(types.DeviceClassId)}, rejected: true},
+		{desc: "device nft send in authz exec", msgs: []sdk.Msg{exec(send(types.DeviceClassId))}, rejected: true},
+		{desc: "device nft send in nested authz exec", msgs: []sdk.Msg{exec(bankSend, exec(send(types.DeviceClassId)))}, rejected: true},
+		{desc: "other nft class", msgs: []sdk.Msg{send("other-class")}},
+		{desc: "other nft class in authz exec", msgs: []sdk.Msg{exec(send("other-class"))}},
+		{desc: "bank send", msgs: []sdk.Msg{bankSend}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			called := false
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				called = true
+				return ctx, nil
+			}
+			_, err := ante.WrapAnteHandler(next)(sdk.Context{}, mockTx{msgs: tc.msgs}, false)
+			if tc.rejected {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+				require.False(t, called)
+			} else {
+				require.NoError(t, err)
+				require.True(t, called)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"contactical/x/reality/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetBond returns the bond of owner, or an empty bond if none was posted.
+func (k Keeper) GetBond(ctx context.Context, owner string) (types.Bond, error) {
+	bond, err := k.Bonds.Get(ctx, owner)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewBond(owner), nil
+	}
+	return bond, err
+}
+
+// setBond stores a bond, dropping it once nothing is left.
+func (k Keeper) setBond(ctx context.Context, bond types.Bond) error {
+	if bond.Amount.IsZero() {
+		return k.Bonds.Remove(ctx, bond.Owner)
+	}
+	return k.Bonds.Set(ctx, bond.Owner, bond)
+}
+
+// AddBond moves amount from owner to the module account and adds it to the
+// owner's bond.
+func (k Keeper) AddBond(ctx context.Context, owner string, amount sdk.Coin) (types.Bond, error) {
+	if amount.Denom != types.BondDenom {
+		return types.Bond{}, errorsmod.Wrapf(types.ErrInvalidBond, "bond must be posted in %s: %s", types.BondDenom, amount)
+	}
+	ownerAddr, err := k.addressCodec.StringToBytes(owner)
+	if err != nil {
+		return types.Bond{}, fmt.Errorf("invalid bond owner: %w", err)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, ownerAddr, types.EscrowAccountName, sdk.NewCoins(amount)); err != nil {
+		return types.Bond{}, fmt.Errorf("failed to escrow bond: %w", err)
+	}
+
+	bond, err := k.GetBond(ctx, owner)
+	if err != nil {
+		return types.Bond{}, err
+	}
+	bond.Amount = bond.Amount.Add(amount)
+	return bond, k.setBond(ctx, bond)
+}
+
+// RequiredBond returns the minimum bond of a registered node, or a zero coin
+// if owner is not a node or its trust tier requires no bond.
+func (k Keeper) RequiredBond(ctx context.Context, owner string) (sdk.Coin, error) {
+	node, err := k.NodeInfo.Get(ctx, owner)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.NewInt64Coin(types.BondDenom, 0), nil
+		}
+		return sdk.Coin{}, err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if minBond, ok := params.MinBond(node.TrustTier); ok {
+		return minBond, nil
+	}
+	return sdk.NewInt64Coin(types.BondDenom, 0), nil
+}
+
+// StartUnbonding moves amount out of the owner's bond into an unbonding entry
+// released after the unbonding period. The entry stays slashable until then.
+func (k Keeper) StartUnbonding(ctx context.Context, owner string, amount sdk.Coin) (types.UnbondingEntry, error) {
+	bond, err := k.GetBond(ctx, owner)
+	if err != nil {
+		return types.UnbondingEntry{}, err
+	}
+	if amount.Denom != types.BondDenom || bond.Amount.IsLT(amount) {
+		return types.UnbondingEntry{}, errorsmod.Wrapf(types.ErrInsufficientBond, "cannot unbond %s from a bond of %s", amount, bond.Amount)
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.UnbondingEntry{}, err
+	}
+
+	bond.Amount = bond.Amount.Sub(amount)
+	if err := k.setBond(ctx, bond); err != nil {
+		return types.UnbondingEntry{}, err
+	}
+
+	completion := sdk.UnwrapSDKContext(ctx).BlockTime().Unix() + int64(params.UnbondingPeriod)
+	key := collections.Join(owner, completion)
+	entry, err := k.Unbondings.Get(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		entry = types.UnbondingEntry{Owner: owner, Amount: amount, CompletionTime: completion}
+	case err != nil:
+		return types.UnbondingEntry{}, err
+	default:
+		entry.Amount = entry.Amount.Add(amount)
+	}
+	if err := k.Unbondings.Set(ctx, key, entry); err != nil {
+		return types.UnbondingEntry{}, err
+	}
+	return entry, k.UnbondingQueue.Set(ctx, collections.Join(completion, owner))
+}
+
+// UnbondAll starts unbonding the whole bond of owner, if any.
+func (k Keeper) UnbondAll(ctx context.Context, owner string) (sdk.Coin, error) {
+	bond, err := k.GetBond(ctx, owner)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if bond.Amount.IsZero() {
+		return bond.Amount, nil
+	}
+	_, err = k.StartUnbonding(ctx, owner, bond.Amount)
+	return bond.Amount, err
+}
+
+// UnbondingEntries returns the pending unbonding entries of owner.
+func (k Keeper) UnbondingEntries(ctx context.Context, owner string) ([]types.UnbondingEntry, error) {
+	var entries []types.UnbondingEntry
+	err := k.Unbondings.Walk(ctx, collections.NewPrefixedPairRange[string, int64](owner), func(_ collections.Pair[string, int64], entry types.UnbondingEntry) (bool, error) {
+		entries = append(entries, entry)
+		return false, nil
+	})
+	return entries, err
+}
+
+// SlashBond burns fraction of the bond and of the pending unbonding entries
+// of owner. It returns the burned amount.
+func (k Keeper) SlashBond(ctx context.Context, owner string, fraction math.LegacyDec, reason string) (sdk.Coin, error) {
+	slashed := sdk.NewInt64Coin(types.BondDenom, 0)
+	if !fraction.IsPositive() {
+		return slashed, nil
+	}
+	slash := func(amount sdk.Coin) sdk.Coin {
+		return sdk.NewCoin(amount.Denom, fraction.MulInt(amount.Amount).TruncateInt())
+	}
+
+	entries, err := k.UnbondingEntries(ctx, owner)
+	if err != nil {
+		return slashed, err
+	}
+	for _, entry := range entries {
+		cut := slash(entry.Amount)
+		entry.Amount = entry.Amount.Sub(cut)
+		slashed = slashed.Add(cut)
+		if err := k.Unbondings.Set(ctx, collections.Join(owner, entry.CompletionTime), entry); err != nil {
+			return slashed, err
+		}
+	}
+
+	bond, err := k.GetBond(ctx, owner)
+	if err != nil {
+		return slashed, err
+	}
+	cut := slash(bond.Amount)
+	bond.Amount = bond.Amount.Sub(cut)
+	slashed = slashed.Add(cut)
+	if slashed.IsZero() {
+		return slashed, nil
+	}
+	bond.Slashed = bond.Slashed.Add(slashed)
+	if err := k.setBond(ctx, bond); err != nil {
+		return slashed, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.EscrowAccountName, sdk.NewCoins(slashed)); err != nil {
+		return slashed, fmt.Errorf("failed to burn slashed bond: %w", err)
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventBondSlashed{
+		Owner:  owner,
+		Amount: slashed,
+		Reason: reason,
+	}); err != nil {
+		return slashed, err
+	}
+	return slashed, nil
+}
+
+// ProcessUnbondings releases every unbonding entry that has completed.
+func (k Keeper) ProcessUnbondings(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+
+	var due []collections.Pair[int64, string]
+	rng := new(collections.Range[collections.Pair[int64, string]]).EndExclusive(collections.Join(now+1, ""))
+	err := k.UnbondingQueue.Walk(ctx, rng, func(key collections.Pair[int64, string]) (bool, error) {
+		due = append(due, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		entryKey := collections.Join(key.K2(), key.K1())
+		entry, err := k.Unbondings.Get(ctx, entryKey)
+		if err != nil {
+			return err
+		}
+		if !entry.Amount.IsZero() {
+			owner, err := k.addressCodec.StringToBytes(entry.Owner)
+			if err != nil {
+				return err
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, owner, sdk.NewCoins(entry.Amount)); err != nil {
+				return fmt.Errorf("failed to release unbonded bond: %w", err)
+			}
+		}
+		if err := k.Unbondings.Remove(ctx, entryKey); err != nil {
+			return err
+		}
+		if err := k.UnbondingQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventUnbondingCompleted{
+			Owner:  entry.Owner,
+			Amount: entry.Amount,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"contactical/x/reality/keeper"
+	"contactical/x/reality/types"
+)
+
+// fundBond mints the minimum bond of a trust tier to owner and returns it.
+func fundBond(t *testing.T, f *fixture, owner string, trustTier int32) sdk.Coin {
+	t.Helper()
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	bond, ok := params.MinBond(trustTier)
+	require.True(t, ok)
+	fund(t, f, owner, sdk.NewCoins(bond))
+	return bond
+}
+
+func TestRegisterNodeRequiresTierBond(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	node := sdk.AccAddress([]byte("bonded_node_________")).String()
+	bond := fundBond(t, f, node, 2)
+
+	half := sdk.NewCoin(bond.Denom, bond.Amount.QuoRaw(2))
+	_, err := ms.RegisterNode(f.ctx, &types.MsgRegisterNode{Creator: node, Nullifier: "n1", Bond: half})
+	require.ErrorIs(t, err, types.ErrInsufficientBond)
+
+	// the msg server is called without a cached context, so the first half
+	// stays posted and topping it up is enough
+	_, err = ms.RegisterNode(f.ctx, &types.MsgRegisterNode{Creator: node, Nullifier: "n2", Bond: half})
+	require.NoError(t, err)
+	require.True(t, f.bankKeeper.balances[node].IsZero())
+
+	resp, err := qs.Bond(f.ctx, &types.QueryBondRequest{Owner: node})
+	require.NoError(t, err)
+	require.Equal(t, bond, resp.Bond.Amount)
+	require.Equal(t, bond, resp.MinBond)
+
+	// a registered node must keep its minimum bond
+	_, err = ms.Unbond(f.ctx, &types.MsgUnbond{Creator: node, Amount: sdk.NewInt64Coin(bond.Denom, 1)})
+	require.ErrorIs(t, err, types.ErrInsufficientBond)
+}
+
+func TestRetireUnbondsAfterPeriod(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.UnbondingPeriod = 100
+	params.BondSlashRevocation = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	start := time.Unix(1_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start)
+	node := sdk.AccAddress([]byte("retiring_node_______")).String()
+	bond := fundBond(t, f, node, 2)
+	_, err := ms.RegisterNode(ctx, &types.MsgRegisterNode{Creator: node, Nullifier: "n1", Bond: bond})
+	require.NoError(t, err)
+
+	_, err = ms.RetireNode(ctx, &types.MsgRetireNode{Creator: node})
+	require.NoError(t, err)
+	entries, err := f.keeper.UnbondingEntries(ctx, node)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, start.Unix()+100, entries[0].CompletionTime)
+	retired := lastEvent[*types.EventNodeRetired](t, ctx)
+	require.Equal(t, node, retired.NodeId)
+	require.Equal(t, bond, retired.Unbonding)
+
+	// unbonding bonds stay slashable
+	slashed, err := f.keeper.SlashBond(ctx, node, params.BondSlashRevocation, "test")
+	require.NoError(t, err)
+	require.Equal(t, bond.Amount.QuoRaw(2), slashed.Amount)
+	require.Equal(t, &types.EventBondSlashed{Owner: node, Amount: slashed, Reason: "test"}, lastEvent[*types.EventBondSlashed](t, ctx))
+
+	ctx = ctx.WithBlockTime(start.Add(99 * time.Second))
+	require.NoError(t, f.keeper.ProcessUnbondings(ctx))
+	require.True(t, f.bankKeeper.balances[node].IsZero())
+
+	ctx = ctx.WithBlockTime(start.Add(100 * time.Second))
+	require.NoError(t, f.keeper.ProcessUnbondings(ctx))
+	require.Equal(t, bond.Amount.Sub(slashed.Amount), f.bankKeeper.balances[node].AmountOf(bond.Denom))
+	require.Equal(t, &types.EventUnbondingCompleted{Owner: node, Amount: bond.Sub(slashed)}, lastEvent[*types.EventUnbondingCompleted](t, ctx))
+	entries, err = f.keeper.UnbondingEntries(ctx, node)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestRelayerBondAndRevocation(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	relayer := sdk.AccAddress([]byte("relayer_____________")).String()
+	bond := fundBond(t, f, relayer, 1)
+	_, err = ms.PostBond(f.ctx, &types.MsgPostBond{Creator: relayer, Amount: bond})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventBondPosted{Owner: relayer, Amount: bond, Total: bond}, lastEvent[*types.EventBondPosted](t, f.ctx))
+
+	// relayers are not nodes and may unbond freely
+	_, err = ms.Unbond(f.ctx, &types.MsgUnbond{Creator: relayer, Amount: bond})
+	require.NoError(t, err)
+	require.Equal(t, bond, lastEvent[*types.EventUnbondingStarted](t, f.ctx).Amount)
+	has, err := f.keeper.Bonds.Has(f.ctx, relayer)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	node := sdk.AccAddress([]byte("revoked_node________")).String()
+	nodeBond := fundBond(t, f, node, 2)
+	_, err = ms.RegisterNode(f.ctx, &types.MsgRegisterNode{Creator: node, Nullifier: "n1", Bond: nodeBond})
+	require.NoError(t, err)
+
+	_, err = ms.RevokeNode(f.ctx, &types.MsgRevokeNode{Authority: node, Node: node})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.RevokeNode(f.ctx, &types.MsgRevokeNode{Authority: authority, Node: node, Reason: "revoked keybox"})
+	require.NoError(t, err)
+
+	// half of the bond is burned and the rest unbonds
+	entries, err := f.keeper.UnbondingEntries(f.ctx, node)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, nodeBond.Amount.QuoRaw(2), entries[0].Amount.Amount)
+	revoked := lastEvent[*types.EventNodeRevoked](t, f.ctx)
+	require.Equal(t, node, revoked.NodeId)
+	require.Equal(t, "revoked keybox", revoked.Reason)
+	require.Equal(t, nodeBond.SubAmount(nodeBond.Amount.QuoRaw(2)), revoked.Slashed)
+}
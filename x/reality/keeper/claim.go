@@ -27,19 +27,35 @@ func (k Keeper) GetClaimCount(ctx sdk.Context) (uint64, error) {
 	return 0, nil
 }
 
-// AppendClaim appends a claim in the store with a new id.
+// AppendClaim appends a claim in the store with a new id, recording the
+// height and time of the current block.
 func (k Keeper) AppendClaim(ctx sdk.Context, claim types.Claim) (uint64, error) {
 	id, err := k.ClaimSeq.Next(ctx)
 	if err != nil {
 		return 0, err
 	}
 	claim.Id = id
-	if err := k.Claim.Set(ctx, id, claim); err != nil {
+	claim.BlockHeight = ctx.BlockHeight()
+	claim.BlockTime = ctx.BlockTime().Unix()
+	if err := k.SetClaim(ctx, claim); err != nil {
 		return 0, err
 	}
 	return id, nil
 }
 
+// SetClaim stores a new claim and indexes it by block time and creator.
+// The indexed fields never change once a claim is stored, so updates of an
+// existing claim go through the Claim map directly.
+func (k Keeper) SetClaim(ctx context.Context, claim types.Claim) error {
+	if err := k.Claim.Set(ctx, claim.Id, claim); err != nil {
+		return err
+	}
+	if err := k.ClaimTimeIndex.Set(ctx, collections.Join(claim.BlockTime, claim.Id)); err != nil {
+		return err
+	}
+	return k.ClaimCreatorIndex.Set(ctx, collections.Join(claim.Creator, claim.Id))
+}
+
 // GetClaim returns a claim from its id.
 func (k Keeper) GetClaim(ctx sdk.Context, id uint64) (types.Claim, bool, error) {
 	claim, err := k.Claim.Get(ctx, id)
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"contactical/x/reality/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// deviceClass describes the x/nft class holding every device identity token.
+var deviceClass = nft.Class{
+	Id:          types.DeviceClassId,
+	Name:        "Contactical Device Identity",
+	Symbol:      "CTDEV",
+	Description: "Soulbound device identity issued to registered Contactical nodes",
+}
+
+// NewDeviceIdentity builds the soulbound token metadata of a node.
+func NewDeviceIdentity(node types.NodeInfo) types.DeviceIdentity {
+	summary := "zk-jwt"
+	if node.TrustTier < 2 {
+		summary = fmt.Sprintf("tee level=%d locked=%t boot=%d os=%d patch=%d",
+			node.SecurityLevel, node.DeviceLocked, node.BootState, node.OsVersion, node.OsPatchLevel)
+	}
+
+	return types.DeviceIdentity{
+		Node:               node.Creator,
+		TrustTier:          node.TrustTier,
+		SecurityLevel:      node.SecurityLevel,
+		AttestationSummary: summary,
+		RegistrationHeight: node.RegisteredAt,
+	}
+}
+
+// MintDeviceIdentity mints (or refreshes) the soulbound NFT of a node.
+// The NFT id is the node address so wallets can look it up directly.
+func (k Keeper) MintDeviceIdentity(ctx context.Context, node types.NodeInfo) error {
+	if !k.nftKeeper.HasClass(ctx, types.DeviceClassId) {
+		if err := k.nftKeeper.SaveClass(ctx, deviceClass); err != nil {
+			return err
+		}
+	}
+
+	identity := NewDeviceIdentity(node)
+	data, err := codectypes.NewAnyWithValue(&identity)
+	if err != nil {
+		return err
+	}
+	token := nft.NFT{
+		ClassId: types.DeviceClassId,
+		Id:      node.Creator,
+		Data:    data,
+	}
+
+	if k.nftKeeper.HasNFT(ctx, types.DeviceClassId, node.Creator) {
+		return k.nftKeeper.Update(ctx, token)
+	}
+
+	owner, err := k.addressCodec.StringToBytes(node.Creator)
+	if err != nil {
+		return err
+	}
+	return k.nftKeeper.Mint(ctx, token, sdk.AccAddress(owner))
+}
+
+// BurnDeviceIdentity burns the soulbound NFT of a node if it exists.
+func (k Keeper) BurnDeviceIdentity(ctx context.Context, node string) error {
+	if !k.nftKeeper.HasNFT(ctx, types.DeviceClassId, node) {
+		return nil
+	}
+	return k.nftKeeper.Burn(ctx, types.DeviceClassId, node)
+}
+
+// RemoveNode deletes a node together with its leaderboard entries and
+// device identity NFT, and records it as retired. The nullifier stays consumed.
+func (k Keeper) RemoveNode(ctx context.Context, creator string) (types.NodeInfo, error) {
+	node, err := k.NodeInfo.Get(ctx, creator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.NodeInfo{}, errorsmod.Wrap(types.ErrUnknownNode, creator)
+		}
+		return types.NodeInfo{}, err
+	}
+
+	if err := k.removeNodeIndexes(ctx, node); err != nil {
+		return types.NodeInfo{}, err
+	}
+	if err := k.NodeInfo.Remove(ctx, creator); err != nil {
+		return types.NodeInfo{}, err
+	}
+	if err := k.RetiredNodes.Set(ctx, creator); err != nil {
+		return types.NodeInfo{}, err
+	}
+	if err := k.BurnDeviceIdentity(ctx, creator); err != nil {
+		return types.NodeInfo{}, err
+	}
+	return node, nil
+}
+
+// removeSanctionedNode removes a node sanctioned by governance. A node that
+// retired before being sanctioned has nothing left to remove, but its bond,
+// unbonding entries and rewards are still subject to the sanction.
+func (k Keeper) removeSanctionedNode(ctx context.Context, creator string) error {
+	registered, err := k.NodeInfo.Has(ctx, creator)
+	if err != nil {
+		return err
+	}
+	if !registered {
+		retired, err := k.RetiredNodes.Has(ctx, creator)
+		if err != nil {
+			return err
+		}
+		if retired {
+			return nil
+		}
+	}
+	_, err = k.RemoveNode(ctx, creator)
+	return err
+}
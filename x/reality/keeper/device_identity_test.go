@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/x/nft"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"contactical/x/reality/keeper"
+	"contactical/x/reality/types"
+)
+
+// mockNFTKeeper is an in-memory stand-in for the x/nft keeper.
+type mockNFTKeeper struct {
+	classes map[string]nft.Class
+	tokens  map[string]nft.NFT
+	owners  map[string]sdk.AccAddress
+}
+
+func newMockNFTKeeper() *mockNFTKeeper {
+	return &mockNFTKeeper{
+		classes: map[string]nft.Class{},
+		tokens:  map[string]nft.NFT{},
+		owners:  map[string]sdk.AccAddress{},
+	}
+}
+
+func (m *mockNFTKeeper) SaveClass(_ context.Context, class nft.Class) error {
+	m.classes[class.Id] = class
+	return nil
+}
+
+func (m *mockNFTKeeper) HasClass(_ context.Context, classID string) bool {
+	_, ok := m.classes[classID]
+	return ok
+}
+
+func (m *mockNFTKeeper) Mint(_ context.Context, token nft.NFT, receiver sdk.AccAddress) error {
+	key := token.ClassId + "/" + token.Id
+	if _, ok := m.tokens[key]; ok {
+		return fmt.Errorf("nft %s already exists", key)
+	}
+	m.tokens[key] = token
+	m.owners[key] = receiver
+	return nil
+}
+
+func (m *mockNFTKeeper) Update(_ context.Context, token nft.NFT) error {
+	m.tokens[token.ClassId+"/"+token.Id] = token
+	return nil
+}
+
+func (m *mockNFTKeeper) Burn(_ context.Context, classID, nftID string) error {
+	delete(m.tokens, classID+"/"+nftID)
+	delete(m.owners, classID+"/"+nftID)
+	return nil
+}
+
+func (m *mockNFTKeeper) HasNFT(_ context.Context, classID, id string) bool {
+	_, ok := m.tokens[classID+"/"+id]
+	return ok
+}
+
+func TestDeviceIdentityLifecycle(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	node := sdk.AccAddress([]byte("device_identity_01__")).String()
+	bond := fundBond(t, f, node, 2)
+	_, err := ms.RegisterNode(f.ctx, &types.MsgRegisterNode{Creator: node, Nullifier: "nullifier-1", Bond: bond})
+	require.NoError(t, err)
+
+	token, ok := f.nftKeeper.tokens[types.DeviceClassId+"/"+node]
+	require.True(t, ok)
+	require.Equal(t, node, f.nftKeeper.owners[types.DeviceClassId+"/"+node].String())
+	var identity types.DeviceIdentity
+	require.NoError(t, identity.Unmarshal(token.Data.Value))
+	require.Equal(t, int32(2), identity.TrustTier)
+	require.Equal(t, "zk-jwt", identity.AttestationSummary)
+
+	_, err = ms.RetireNode(f.ctx, &types.MsgRetireNode{Creator: node})
+	require.NoError(t, err)
+	require.False(t, f.nftKeeper.HasNFT(f.ctx, types.DeviceClassId, node))
+	has, err := f.keeper.NodeInfo.Has(f.ctx, node)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = ms.RetireNode(f.ctx, &types.MsgRetireNode{Creator: node})
+	require.Error(t, err)
+}
+
+func TestBanNode(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	node := sdk.AccAddress([]byte("device_identity_02__")).String()
+	bond := fundBond(t, f, node, 2)
+	_, err = ms.RegisterNode(f.ctx, &types.MsgRegisterNode{Creator: node, Nullifier: "nullifier-2", Bond: bond})
+	require.NoError(t, err)
+
+	_, err = ms.BanNode(f.ctx, &types.MsgBanNode{Authority: node, Node: node})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = ms.BanNode(f.ctx, &types.MsgBanNode{Authority: authority, Node: node, Reason: "spoofed location"})
+	require.NoError(t, err)
+	require.False(t, f.nftKeeper.HasNFT(f.ctx, types.DeviceClassId, node))
+	banned := lastEvent[*types.EventNodeBanned](t, f.ctx)
+	require.Equal(t, node, banned.NodeId)
+	require.Equal(t, "spoofed location", banned.Reason)
+
+	_, err = ms.RegisterNode(f.ctx, &types.MsgRegisterNode{Creator: node, Nullifier: "nullifier-3"})
+	require.Error(t, err)
+}
+
+func TestBanRetiredNode(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	node := sdk.AccAddress([]byte("device_identity_03__")).String()
+	bond := fundBond(t, f, node, 2)
+	_, err = ms.RegisterNode(f.ctx, &types.MsgRegisterNode{Creator: node, Nullifier: "nullifier-4", Bond: bond})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.TallyReward(f.ctx, node, "wydm9", node, 100))
+	require.NoError(t, f.keeper.DistributeEpochRewards(f.ctx, 1))
+
+	// 은퇴로 보증금 전부가 언본딩 중이어도 차단, 회수, 슬래싱이 적용됨
+	_, err = ms.RetireNode(f.ctx, &types.MsgRetireNode{Creator: node})
+	require.NoError(t, err)
+	_, err = ms.BanNode(f.ctx, &types.MsgBanNode{Authority: authority, Node: node, Reason: "spoofed location"})
+	require.NoError(t, err)
+
+	banned, err := f.keeper.BannedNodes.Has(f.ctx, node)
+	require.NoError(t, err)
+	require.True(t, banned)
+	event := lastEvent[*types.EventNodeBanned](t, f.ctx)
+	require.Equal(t, bond, event.Slashed)
+	require.False(t, event.Clawback.IsZero())
+	entries, err := f.keeper.UnbondingEntries(f.ctx, node)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.True(t, entries[0].Amount.IsZero())
+
+	// 등록된 적 없는 노드는 여전히 거부됨
+	unknown := sdk.AccAddress([]byte("device_identity_04__")).String()
+	_, err = ms.BanNode(f.ctx, &types.MsgBanNode{Authority: authority, Node: unknown})
+	require.ErrorIs(t, err, types.ErrUnknownNode)
+	_, err = ms.RevokeNode(f.ctx, &types.MsgRevokeNode{Authority: authority, Node: unknown})
+	require.ErrorIs(t, err, types.ErrUnknownNode)
+}
+
+func TestRetiredBannedNodeCannotRegister(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	node := sdk.AccAddress([]byte("device_identity_05__")).String()
+	_, err = ms.RegisterNode(f.ctx, &types.MsgRegisterNode{Creator: node, Nullifier: "nullifier-5", Bond: fundBond(t, f, node, 2)})
+	require.NoError(t, err)
+	_, err = ms.RetireNode(f.ctx, &types.MsgRetireNode{Creator: node})
+	require.NoError(t, err)
+	_, err = ms.BanNode(f.ctx, &types.MsgBanNode{Authority: authority, Node: node})
+	require.NoError(t, err)
+
+	// 은퇴 후 차단된 노드는 TEE 경로로도 다시 등록할 수 없음
+	_, err = ms.RegisterNode(f.ctx, &types.MsgRegisterNode{Creator: node, Challenge: "challenge", Bond: fundBond(t, f, node, 1)})
+	require.ErrorIs(t, err, types.ErrNodeBanned)
+	has, err := f.keeper.NodeInfo.Has(f.ctx, node)
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"contactical/x/reality/keeper"
+	"contactical/x/reality/types"
+)
+
+func setupDispute(t *testing.T, f *fixture) (sdk.Context, string, string, uint64) {
+	t.Helper()
+
+	params := types.DefaultParams()
+	params.DisputeMinBond = sdk.NewInt64Coin(types.DefaultRewardDenom, 100)
+	params.DisputeResponseWindow = 10
+	params.DisputeResolutionWindow = 20
+	params.DisputeReputationPenalty = 30
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000_000, 0))
+	node := sdk.AccAddress([]byte("disputed_node_______")).String()
+	challenger := sdk.AccAddress([]byte("challenger__________")).String()
+	funds := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 1000))
+	require.NoError(t, f.bankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(challenger), funds))
+
+	require.NoError(t, f.keeper.SetNodeInfo(ctx, types.NodeInfo{Creator: node, Reputation: 50}))
+	claimID, err := f.keeper.AppendClaim(ctx, types.Claim{Creator: node})
+	require.NoError(t, err)
+	return ctx, node, challenger, claimID
+}
+
+func TestDisputeUpheldAfterResolution(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx, node, challenger, claimID := setupDispute(t, f)
+	bond := sdk.NewInt64Coin(types.DefaultRewardDenom, 200)
+	nodeBond := fundBond(t, f, node, 2)
+	_, err := f.keeper.AddBond(ctx, node, nodeBond)
+	require.NoError(t, err)
+
+	_, err = ms.ChallengeClaim(ctx, &types.MsgChallengeClaim{Creator: node, ClaimId: claimID, Bond: bond, Reason: "own"})
+	require.ErrorIs(t, err, types.ErrInvalidDispute)
+	_, err = ms.ChallengeClaim(ctx, &types.MsgChallengeClaim{Creator: challenger, ClaimId: claimID, Bond: sdk.NewInt64Coin(types.DefaultRewardDenom, 50), Reason: "low"})
+	require.ErrorIs(t, err, types.ErrInsufficientBond)
+
+	resp, err := ms.ChallengeClaim(ctx, &types.MsgChallengeClaim{Creator: challenger, ClaimId: claimID, Bond: bond, Reason: "spoofed gnss"})
+	require.NoError(t, err)
+	require.Equal(t, int64(800), f.bankKeeper.balances[challenger].AmountOf(types.DefaultRewardDenom).Int64())
+	opened := lastEvent[*types.EventDisputeOpened](t, ctx)
+	require.Equal(t, resp.DisputeId, opened.DisputeId)
+	require.Equal(t, claimID, opened.ClaimId)
+	require.Equal(t, node, opened.NodeId)
+	require.Equal(t, bond, opened.Bond)
+
+	// one active dispute per claim
+	_, err = ms.ChallengeClaim(ctx, &types.MsgChallengeClaim{Creator: challenger, ClaimId: claimID, Bond: bond, Reason: "again"})
+	require.ErrorIs(t, err, types.ErrInvalidDispute)
+
+	_, err = ms.RespondToChallenge(ctx, &types.MsgRespondToChallenge{Creator: challenger, DisputeId: resp.DisputeId, Payload: "p"})
+	require.ErrorIs(t, err, types.ErrInvalidDispute)
+	_, err = ms.RespondToChallenge(ctx, &types.MsgRespondToChallenge{Creator: node, DisputeId: resp.DisputeId, Payload: "p", WitnessSignatures: []string{"w1"}})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), lastEvent[*types.EventDisputeResponded](t, ctx).Witnesses)
+
+	// only governance or the jury can resolve
+	_, err = ms.ResolveDispute(ctx, &types.MsgResolveDispute{Authority: challenger, DisputeId: resp.DisputeId, Upheld: true})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	gov, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	_, err = ms.ResolveDispute(ctx, &types.MsgResolveDispute{Authority: gov, DisputeId: resp.DisputeId, Upheld: true})
+	require.NoError(t, err)
+
+	dispute, err := qs.Dispute(ctx, &types.QueryDisputeRequest{Id: resp.DisputeId})
+	require.NoError(t, err)
+	require.Equal(t, types.DISPUTE_STATUS_UPHELD, dispute.Dispute.Status)
+	require.Equal(t, []string{"w1"}, dispute.Dispute.Evidence.WitnessSignatures)
+	require.Equal(t, int64(1000), f.bankKeeper.balances[challenger].AmountOf(types.DefaultRewardDenom).Int64())
+
+	claim, err := f.keeper.Claim.Get(ctx, claimID)
+	require.NoError(t, err)
+	require.True(t, claim.ClawedBack)
+
+	// the node bond is slashed by the dispute fraction
+	slashed := types.DefaultParams().BondSlashDispute.MulInt(nodeBond.Amount).TruncateInt()
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(nodeBond.Denom, slashed)), dispute.Dispute.Slashed)
+	resolved := lastEvent[*types.EventDisputeResolved](t, ctx)
+	require.Equal(t, types.DISPUTE_STATUS_UPHELD, resolved.Status)
+	require.Equal(t, gov, resolved.Resolver)
+	require.Equal(t, dispute.Dispute.Slashed, resolved.Slashed)
+	nodeBondAfter, err := f.keeper.GetBond(ctx, node)
+	require.NoError(t, err)
+	require.Equal(t, nodeBond.Amount.Sub(slashed), nodeBondAfter.Amount.Amount)
+	info, err := f.keeper.NodeInfo.Get(ctx, node)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), info.Reputation)
+
+	has, err := f.keeper.ActiveDisputes.Has(ctx, claimID)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestDisputeRejectedByJury(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, node, challenger, claimID := setupDispute(t, f)
+
+	jury := sdk.AccAddress([]byte("dispute_jury________")).String()
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.DisputeJury = jury
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	bond := sdk.NewInt64Coin(types.DefaultRewardDenom, 200)
+	resp, err := ms.ChallengeClaim(ctx, &types.MsgChallengeClaim{Creator: challenger, ClaimId: claimID, Bond: bond, Reason: "spoofed"})
+	require.NoError(t, err)
+	_, err = ms.RespondToChallenge(ctx, &types.MsgRespondToChallenge{Creator: node, DisputeId: resp.DisputeId, Payload: "p"})
+	require.NoError(t, err)
+	_, err = ms.ResolveDispute(ctx, &types.MsgResolveDispute{Authority: jury, DisputeId: resp.DisputeId, Upheld: false})
+	require.NoError(t, err)
+
+	// half of the bond is burned
+	require.Equal(t, int64(900), f.bankKeeper.balances[challenger].AmountOf(types.DefaultRewardDenom).Int64())
+	require.Equal(t, int64(900), f.bankKeeper.supply.AmountOf(types.DefaultRewardDenom).Int64())
+
+	dispute, err := f.keeper.Disputes.Get(ctx, resp.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, types.DISPUTE_STATUS_REJECTED, dispute.Status)
+	claim, err := f.keeper.Claim.Get(ctx, claimID)
+	require.NoError(t, err)
+	require.False(t, claim.ClawedBack)
+}
+
+func TestDisputeDeadlines(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx, node, challenger, claimID := setupDispute(t, f)
+	secondClaim, err := f.keeper.AppendClaim(ctx, types.Claim{Creator: node})
+	require.NoError(t, err)
+
+	bond := sdk.NewInt64Coin(types.DefaultRewardDenom, 100)
+	first, err := ms.ChallengeClaim(ctx, &types.MsgChallengeClaim{Creator: challenger, ClaimId: claimID, Bond: bond, Reason: "a"})
+	require.NoError(t, err)
+	second, err := ms.ChallengeClaim(ctx, &types.MsgChallengeClaim{Creator: challenger, ClaimId: secondClaim, Bond: bond, Reason: "b"})
+	require.NoError(t, err)
+	_, err = ms.RespondToChallenge(ctx, &types.MsgRespondToChallenge{Creator: node, DisputeId: second.DisputeId, Payload: "p"})
+	require.NoError(t, err)
+
+	// the response deadline is inclusive
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10 * time.Second))
+	require.NoError(t, f.keeper.ProcessDisputeDeadlines(ctx))
+	active, err := qs.ListDispute(ctx, &types.QueryListDisputeRequest{Status: types.DISPUTE_STATUS_AWAITING_RESPONSE})
+	require.NoError(t, err)
+	require.Len(t, active.Dispute, 1)
+
+	// the unanswered dispute is upheld by default
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	require.NoError(t, f.keeper.ProcessDisputeDeadlines(ctx))
+	dispute, err := f.keeper.Disputes.Get(ctx, first.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, types.DISPUTE_STATUS_UPHELD, dispute.Status)
+	_, err = ms.RespondToChallenge(ctx, &types.MsgRespondToChallenge{Creator: node, DisputeId: first.DisputeId, Payload: "late"})
+	require.ErrorIs(t, err, types.ErrInvalidDispute)
+
+	// the unresolved dispute expires and refunds the bond
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(20 * time.Second))
+	require.NoError(t, f.keeper.ProcessDisputeDeadlines(ctx))
+	dispute, err = f.keeper.Disputes.Get(ctx, second.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, types.DISPUTE_STATUS_EXPIRED, dispute.Status)
+	require.Equal(t, int64(1000), f.bankKeeper.balances[challenger].AmountOf(types.DefaultRewardDenom).Int64())
+
+	disputes, err := qs.ClaimDisputes(ctx, &types.QueryClaimDisputesRequest{ClaimId: secondClaim})
+	require.NoError(t, err)
+	require.Len(t, disputes.Dispute, 1)
+
+	iter, err := f.keeper.DisputeDeadlines.Iterate(ctx, nil)
+	require.NoError(t, err)
+	require.False(t, iter.Valid())
+	iter.Close()
+}
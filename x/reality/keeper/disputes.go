@@ -0,0 +1,306 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"contactical/x/reality/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OpenDispute escrows the challenger's bond in the module account and opens a
+// dispute against a claim. The challenged node has until the response deadline
+// to submit more evidence.
+func (k Keeper) OpenDispute(ctx context.Context, challenger string, claimID uint64, bond sdk.Coin, reason string) (types.Dispute, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.Dispute{}, err
+	}
+	if bond.Denom != params.DisputeMinBond.Denom || bond.IsLT(params.DisputeMinBond) {
+		return types.Dispute{}, errorsmod.Wrapf(types.ErrInsufficientBond, "dispute bond %s is below the minimum %s", bond, params.DisputeMinBond)
+	}
+
+	claim, err := k.Claim.Get(ctx, claimID)
+	if err != nil {
+		return types.Dispute{}, errorsmod.Wrapf(types.ErrClaimNotFound, "claim %d", claimID)
+	}
+	if claim.ClawedBack {
+		return types.Dispute{}, errorsmod.Wrapf(types.ErrClaimClawedBack, "claim %d", claimID)
+	}
+	if claim.Creator == challenger {
+		return types.Dispute{}, errorsmod.Wrapf(types.ErrInvalidDispute, "cannot challenge own claim %d", claimID)
+	}
+	if active, err := k.ActiveDisputes.Has(ctx, claimID); err != nil {
+		return types.Dispute{}, err
+	} else if active {
+		return types.Dispute{}, errorsmod.Wrapf(types.ErrInvalidDispute, "claim %d already has an active dispute", claimID)
+	}
+
+	challengerAddr, err := k.addressCodec.StringToBytes(challenger)
+	if err != nil {
+		return types.Dispute{}, fmt.Errorf("invalid challenger address: %w", err)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, challengerAddr, types.EscrowAccountName, sdk.NewCoins(bond)); err != nil {
+		return types.Dispute{}, fmt.Errorf("failed to escrow dispute bond: %w", err)
+	}
+
+	id, err := k.DisputeSeq.Next(ctx)
+	if err != nil {
+		return types.Dispute{}, err
+	}
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	dispute := types.Dispute{
+		Id:               id,
+		ClaimId:          claimID,
+		Challenger:       challenger,
+		Node:             claim.Creator,
+		Bond:             bond,
+		Reason:           reason,
+		Status:           types.DISPUTE_STATUS_AWAITING_RESPONSE,
+		CreatedAt:        now,
+		ResponseDeadline: now + int64(params.DisputeResponseWindow),
+	}
+	return dispute, k.SetDispute(ctx, dispute)
+}
+
+// RespondToDispute records the evidence of the challenged node and moves the
+// dispute to governance or the dispute jury.
+func (k Keeper) RespondToDispute(ctx context.Context, node string, disputeID uint64, evidence types.DisputeEvidence) (types.Dispute, error) {
+	dispute, err := k.Disputes.Get(ctx, disputeID)
+	if err != nil {
+		return types.Dispute{}, errorsmod.Wrapf(types.ErrDisputeNotFound, "dispute %d", disputeID)
+	}
+	if dispute.Node != node {
+		return types.Dispute{}, errorsmod.Wrapf(types.ErrInvalidDispute, "only the challenged node %s can respond to dispute %d", dispute.Node, disputeID)
+	}
+	if dispute.Status != types.DISPUTE_STATUS_AWAITING_RESPONSE {
+		return types.Dispute{}, errorsmod.Wrapf(types.ErrInvalidDispute, "dispute %d is not awaiting a response: %s", disputeID, dispute.Status)
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	if now > dispute.ResponseDeadline {
+		return types.Dispute{}, errorsmod.Wrapf(types.ErrResponseWindowClosed, "dispute %d closed at %d", disputeID, dispute.ResponseDeadline)
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.Dispute{}, err
+	}
+
+	evidence.SubmittedAt = now
+	dispute.Evidence = &evidence
+	dispute.Status = types.DISPUTE_STATUS_AWAITING_RESOLUTION
+	dispute.ResolutionDeadline = now + int64(params.DisputeResolutionWindow)
+	return dispute, k.SetDispute(ctx, dispute)
+}
+
+// IsDisputeResolver reports whether the address may resolve disputes, i.e. it
+// is the module authority or the configured dispute jury group policy.
+func (k Keeper) IsDisputeResolver(ctx context.Context, resolver string) (bool, error) {
+	addr, err := k.addressCodec.StringToBytes(resolver)
+	if err != nil {
+		return false, err
+	}
+	if bytes.Equal(k.GetAuthority(), addr) {
+		return true, nil
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	return params.DisputeJury != "" && params.DisputeJury == resolver, nil
+}
+
+// DecideDispute settles a dispute that has received the node's response.
+func (k Keeper) DecideDispute(ctx context.Context, disputeID uint64, resolver string, upheld bool, rationale string) (types.Dispute, error) {
+	dispute, err := k.Disputes.Get(ctx, disputeID)
+	if err != nil {
+		return types.Dispute{}, errorsmod.Wrapf(types.ErrDisputeNotFound, "dispute %d", disputeID)
+	}
+	if dispute.Status != types.DISPUTE_STATUS_AWAITING_RESOLUTION {
+		return types.Dispute{}, errorsmod.Wrapf(types.ErrInvalidDispute, "dispute %d is not awaiting a resolution: %s", disputeID, dispute.Status)
+	}
+
+	status := types.DISPUTE_STATUS_REJECTED
+	if upheld {
+		status = types.DISPUTE_STATUS_UPHELD
+	}
+	return k.settleDispute(ctx, dispute, status, resolver, rationale)
+}
+
+// ProcessDisputeDeadlines settles every dispute whose deadline has passed.
+// A node that never responded loses the dispute; a dispute nobody resolved in
+// time expires and the challenger gets the bond back.
+func (k Keeper) ProcessDisputeDeadlines(ctx context.Context) error {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	var due []uint64
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).EndExclusive(collections.Join(now, uint64(0)))
+	err := k.DisputeDeadlines.Walk(ctx, rng, func(key collections.Pair[int64, uint64]) (bool, error) {
+		due = append(due, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range due {
+		dispute, err := k.Disputes.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		switch dispute.Status {
+		case types.DISPUTE_STATUS_AWAITING_RESPONSE:
+			_, err = k.settleDispute(ctx, dispute, types.DISPUTE_STATUS_UPHELD, "", "no response before the deadline")
+		case types.DISPUTE_STATUS_AWAITING_RESOLUTION:
+			_, err = k.settleDispute(ctx, dispute, types.DISPUTE_STATUS_EXPIRED, "", "not resolved before the deadline")
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// settleDispute applies the outcome of a dispute:
+//   - UPHELD: the claim rewards are clawed back, the node loses reputation,
+//     the node and relayer bonds are slashed and the challenger gets the bond
+//     back.
+//   - REJECTED: part of the challenger's bond is burned, the rest is refunded.
+//   - EXPIRED: the bond is refunded.
+func (k Keeper) settleDispute(ctx context.Context, dispute types.Dispute, status types.DisputeStatus, resolver, rationale string) (types.Dispute, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.Dispute{}, err
+	}
+
+	refund := sdk.NewCoins(dispute.Bond)
+	slashed := sdk.NewCoins()
+	clawback := sdk.NewCoins()
+	switch status {
+	case types.DISPUTE_STATUS_UPHELD:
+		if clawback, err = k.ClawbackClaim(ctx, dispute.ClaimId); err != nil {
+			return types.Dispute{}, err
+		}
+		if err := k.penalizeReputation(ctx, dispute.Node, params.DisputeReputationPenalty); err != nil {
+			return types.Dispute{}, err
+		}
+		if slashed, err = k.slashDisputedClaim(ctx, dispute, params.BondSlashDispute); err != nil {
+			return types.Dispute{}, err
+		}
+	case types.DISPUTE_STATUS_REJECTED:
+		slashed = sdk.NewCoins(sdk.NewCoin(dispute.Bond.Denom, params.DisputeSlashFraction.MulInt(dispute.Bond.Amount).TruncateInt()))
+		refund = refund.Sub(slashed...)
+		if !slashed.IsZero() {
+			if err := k.bankKeeper.BurnCoins(ctx, types.EscrowAccountName, slashed); err != nil {
+				return types.Dispute{}, fmt.Errorf("failed to burn dispute bond: %w", err)
+			}
+		}
+	}
+
+	if !refund.IsZero() {
+		challenger, err := k.addressCodec.StringToBytes(dispute.Challenger)
+		if err != nil {
+			return types.Dispute{}, err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, challenger, refund); err != nil {
+			return types.Dispute{}, fmt.Errorf("failed to refund dispute bond: %w", err)
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	dispute.Status = status
+	dispute.Resolver = resolver
+	dispute.Rationale = rationale
+	dispute.ResolvedAt = sdkCtx.BlockTime().Unix()
+	dispute.Slashed = slashed
+	dispute.ClawedBack = clawback
+	if err := k.SetDispute(ctx, dispute); err != nil {
+		return types.Dispute{}, err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventDisputeResolved{
+		DisputeId: dispute.Id,
+		ClaimId:   dispute.ClaimId,
+		Status:    status,
+		Resolver:  resolver,
+		Slashed:   slashed,
+		Clawback:  clawback,
+	}); err != nil {
+		return types.Dispute{}, err
+	}
+	return dispute, nil
+}
+
+// slashDisputedClaim slashes the bonds of the node and of the relayer of a
+// claim found fraudulent.
+func (k Keeper) slashDisputedClaim(ctx context.Context, dispute types.Dispute, fraction math.LegacyDec) (sdk.Coins, error) {
+	claim, err := k.Claim.Get(ctx, dispute.ClaimId)
+	if err != nil {
+		return nil, err
+	}
+	owners := []string{dispute.Node}
+	if claim.Relayer != "" && claim.Relayer != dispute.Node {
+		owners = append(owners, claim.Relayer)
+	}
+
+	slashed := sdk.NewCoins()
+	reason := fmt.Sprintf("dispute %d", dispute.Id)
+	for _, owner := range owners {
+		amount, err := k.SlashBond(ctx, owner, fraction, reason)
+		if err != nil {
+			return nil, err
+		}
+		slashed = slashed.Add(amount)
+	}
+	return slashed, nil
+}
+
+// penalizeReputation lowers the reputation of a node, never below zero.
+// Retired or banned nodes are skipped.
+func (k Keeper) penalizeReputation(ctx context.Context, creator string, penalty int64) error {
+	node, err := k.NodeInfo.Get(ctx, creator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	node.Reputation = max(node.Reputation-penalty, 0)
+	return k.SetNodeInfo(ctx, node)
+}
+
+// SetDispute stores a dispute and keeps the claim, active and deadline
+// indexes in sync.
+func (k Keeper) SetDispute(ctx context.Context, dispute types.Dispute) error {
+	old, err := k.Disputes.Get(ctx, dispute.Id)
+	switch {
+	case err == nil:
+		if old.IsActive() {
+			if err := k.DisputeDeadlines.Remove(ctx, collections.Join(old.Deadline(), old.Id)); err != nil {
+				return err
+			}
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.Disputes.Set(ctx, dispute.Id, dispute); err != nil {
+		return err
+	}
+	if err := k.ClaimDisputes.Set(ctx, collections.Join(dispute.ClaimId, dispute.Id)); err != nil {
+		return err
+	}
+	if !dispute.IsActive() {
+		return k.ActiveDisputes.Remove(ctx, dispute.ClaimId)
+	}
+	if err := k.ActiveDisputes.Set(ctx, dispute.ClaimId, dispute.Id); err != nil {
+		return err
+	}
+	return k.DisputeDeadlines.Set(ctx, collections.Join(dispute.Deadline(), dispute.Id))
+}
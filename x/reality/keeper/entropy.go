@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"contactical/x/reality/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RewardWeightOf returns the adaptive reward weight of a node or region.
+// Participants without a stored weight get the weight stored under the empty
+// key of their kind, or are neutral (1) when there is none. Nodes without a
+// region are neutral.
+func (k Keeper) RewardWeightOf(ctx context.Context, kind, key string) (math.LegacyDec, error) {
+	if key == "" {
+		return math.LegacyOneDec(), nil
+	}
+	for _, stored := range []string{key, ""} {
+		weight, err := k.RewardWeight.Get(ctx, collections.Join(kind, stored))
+		if err == nil {
+			return weight, nil
+		}
+		if !errors.Is(err, collections.ErrNotFound) {
+			return math.LegacyDec{}, err
+		}
+	}
+	return math.LegacyOneDec(), nil
+}
+
+// ApplyRewardWeights scales a base reward by the node and region weights.
+func (k Keeper) ApplyRewardWeights(ctx context.Context, node, region string, amount int64) (int64, error) {
+	nodeWeight, err := k.RewardWeightOf(ctx, types.TallyKindNode, node)
+	if err != nil {
+		return 0, err
+	}
+	regionWeight, err := k.RewardWeightOf(ctx, types.TallyKindRegion, region)
+	if err != nil {
+		return 0, err
+	}
+	return math.LegacyNewDec(amount).Mul(nodeWeight).Mul(regionWeight).TruncateInt64(), nil
+}
+
+// TallyReward adds a paid reward to the node, region and relayer tallies of
+// the epoch in progress.
+func (k Keeper) TallyReward(ctx context.Context, node, region, relayer string, amount int64) error {
+	if amount <= 0 {
+		return nil
+	}
+	for _, entry := range [][2]string{
+		{types.TallyKindNode, node},
+		{types.TallyKindRegion, region},
+		{types.TallyKindRelayer, relayer},
+	} {
+		if err := k.addTally(ctx, entry[0], entry[1], amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UntallyReward removes a reward that was tallied in the epoch in progress,
+// e.g. when its claim is clawed back before the epoch ends.
+func (k Keeper) UntallyReward(ctx context.Context, node, region, relayer string, amount int64) error {
+	if amount <= 0 {
+		return nil
+	}
+	for _, entry := range [][2]string{
+		{types.TallyKindNode, node},
+		{types.TallyKindRegion, region},
+		{types.TallyKindRelayer, relayer},
+	} {
+		if err := k.addTally(ctx, entry[0], entry[1], -amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addTally adds delta to a tally, dropping it once it reaches zero.
+func (k Keeper) addTally(ctx context.Context, kind, key string, delta int64) error {
+	if key == "" {
+		return nil
+	}
+	current, err := k.RewardTally.Get(ctx, collections.Join(kind, key))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if current+delta <= 0 {
+		return k.RewardTally.Remove(ctx, collections.Join(kind, key))
+	}
+	return k.RewardTally.Set(ctx, collections.Join(kind, key), current+delta)
+}
+
+// tallies returns the reward tally of every participant of the given kind.
+func (k Keeper) tallies(ctx context.Context, kind string) (keys []string, amounts []int64, err error) {
+	err = k.RewardTally.Walk(ctx, collections.NewPrefixedPairRange[string, string](kind), func(key collections.Pair[string, string], amount int64) (bool, error) {
+		keys = append(keys, key.K2())
+		amounts = append(amounts, amount)
+		return false, nil
+	})
+	return keys, amounts, err
+}
+
+// CurrentEntropy computes the entropy snapshot of the epoch in progress.
+func (k Keeper) CurrentEntropy(ctx context.Context, epochNumber int64) (types.EntropySnapshot, error) {
+	snapshot := types.EntropySnapshot{
+		EpochNumber: epochNumber,
+		BlockHeight: sdk.UnwrapSDKContext(ctx).BlockHeight(),
+	}
+
+	for _, kind := range []string{types.TallyKindNode, types.TallyKindRegion, types.TallyKindRelayer} {
+		_, amounts, err := k.tallies(ctx, kind)
+		if err != nil {
+			return types.EntropySnapshot{}, err
+		}
+		entropy, err := types.NormalizedEntropy(amounts)
+		if err != nil {
+			return types.EntropySnapshot{}, err
+		}
+
+		switch kind {
+		case types.TallyKindNode:
+			snapshot.NodeEntropy = entropy
+			snapshot.NodeCount = uint64(len(amounts))
+			for _, amount := range amounts {
+				snapshot.TotalRewards += amount
+			}
+		case types.TallyKindRegion:
+			snapshot.RegionEntropy = entropy
+			snapshot.RegionCount = uint64(len(amounts))
+		case types.TallyKindRelayer:
+			snapshot.RelayerEntropy = entropy
+			snapshot.RelayerCount = uint64(len(amounts))
+		}
+	}
+	return snapshot, nil
+}
+
+// RecordEntropy stores the entropy snapshot of a finished epoch, adjusts the
+// reward weights toward under-represented nodes and regions when the entropy
+// falls below the governance target, and resets the epoch tallies.
+func (k Keeper) RecordEntropy(ctx context.Context, epochNumber int64) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	snapshot, err := k.CurrentEntropy(ctx, epochNumber)
+	if err != nil {
+		return err
+	}
+
+	// 보상 수령자가 1명 이하인 epoch는 분포 판단 근거가 없으므로 기존 가중치를 유지
+	if snapshot.NodeCount > 1 {
+		adjusted, err := k.adjustRewardWeights(ctx, types.TallyKindNode, snapshot.NodeEntropy, params)
+		if err != nil {
+			return err
+		}
+		snapshot.AdaptiveWeighting = adjusted
+	}
+	if snapshot.RegionCount > 1 {
+		adjusted, err := k.adjustRewardWeights(ctx, types.TallyKindRegion, snapshot.RegionEntropy, params)
+		if err != nil {
+			return err
+		}
+		snapshot.AdaptiveWeighting = snapshot.AdaptiveWeighting || adjusted
+	}
+
+	if err := k.EntropyHistory.Set(ctx, epochNumber, snapshot); err != nil {
+		return err
+	}
+	return k.RewardTally.Clear(ctx, nil)
+}
+
+// adjustRewardWeights replaces the weights of the given kind. Below the target
+// every participant gets mean/share bounded by MaxRewardBoost, and those
+// without rewards in the epoch, like newcomers, get MaxRewardBoost; otherwise
+// all weights are reset to neutral. It reports whether weighting is active.
+func (k Keeper) adjustRewardWeights(ctx context.Context, kind string, entropy math.LegacyDec, params types.Params) (bool, error) {
+	if err := k.RewardWeight.Clear(ctx, collections.NewPrefixedPairRange[string, string](kind)); err != nil {
+		return false, err
+	}
+	if entropy.GTE(params.EntropyTarget) {
+		return false, nil
+	}
+
+	keys, amounts, err := k.tallies(ctx, kind)
+	if err != nil {
+		return false, err
+	}
+	// 지난 epoch에 보상이 없던 참여자는 빈 키의 가중치로 최대 부스트를 받음
+	if err := k.RewardWeight.Set(ctx, collections.Join(kind, ""), params.MaxRewardBoost); err != nil {
+		return false, err
+	}
+	total := int64(0)
+	for _, amount := range amounts {
+		total += amount
+	}
+	for i, key := range keys {
+		weight := types.AdaptiveWeight(amounts[i], total, int64(len(keys)), params.MaxRewardBoost)
+		if err := k.RewardWeight.Set(ctx, collections.Join(kind, key), weight); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"contactical/x/reality/keeper"
+	"contactical/x/reality/types"
+)
+
+func TestNormalizedEntropy(t *testing.T) {
+	even, err := types.NormalizedEntropy([]int64{100, 100, 100, 100})
+	require.NoError(t, err)
+	require.True(t, even.Sub(math.LegacyOneDec()).Abs().LT(math.LegacyNewDecWithPrec(1, 9)), even.String())
+
+	single, err := types.NormalizedEntropy([]int64{500, 0})
+	require.NoError(t, err)
+	require.True(t, single.IsZero())
+
+	// H(0.75, 0.25) = 0.811278...
+	skewed, err := types.NormalizedEntropy([]int64{300, 100})
+	require.NoError(t, err)
+	require.Equal(t, "0.811278", skewed.String()[:8])
+
+	bits, err := types.Log2(math.LegacyNewDec(8))
+	require.NoError(t, err)
+	require.True(t, bits.Sub(math.LegacyNewDec(3)).Abs().LT(math.LegacyNewDecWithPrec(1, 9)))
+}
+
+func TestRecordEntropyAdjustsWeights(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	require.NoError(t, f.keeper.SetNodeInfo(f.ctx, types.NodeInfo{Creator: "node-a", Region: "wydm9"}))
+	require.NoError(t, f.keeper.SetNodeInfo(f.ctx, types.NodeInfo{Creator: "node-b", Region: "xn774"}))
+	require.NoError(t, f.keeper.TallyReward(f.ctx, "node-a", "wydm9", "relayer-1", 900))
+	require.NoError(t, f.keeper.TallyReward(f.ctx, "node-b", "xn774", "relayer-1", 100))
+
+	resp, err := qs.Entropy(f.ctx, &types.QueryEntropyRequest{})
+	require.NoError(t, err)
+	require.Nil(t, resp.Latest)
+	require.Equal(t, uint64(2), resp.Current.NodeCount)
+	require.Equal(t, int64(1000), resp.Current.TotalRewards)
+	require.True(t, resp.Current.RelayerEntropy.IsZero())
+
+	require.NoError(t, f.keeper.RecordEntropy(f.ctx, 1))
+
+	resp, err = qs.Entropy(f.ctx, &types.QueryEntropyRequest{})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Latest)
+	require.True(t, resp.Latest.AdaptiveWeighting)
+	require.True(t, resp.Latest.NodeEntropy.LT(types.DefaultParams().EntropyTarget))
+	require.Zero(t, resp.Current.NodeCount)
+
+	// mean share is 500: node-a is damped to 5/9, node-b is capped at 2x
+	weights, err := qs.RewardWeights(f.ctx, &types.QueryRewardWeightsRequest{Node: "node-a"})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(5).Quo(math.LegacyNewDec(9)), weights.NodeWeight)
+	require.Equal(t, math.LegacyNewDec(5).Quo(math.LegacyNewDec(9)), weights.RegionWeight)
+	weights, err = qs.RewardWeights(f.ctx, &types.QueryRewardWeightsRequest{Node: "node-b"})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(2), weights.NodeWeight)
+
+	boosted, err := f.keeper.ApplyRewardWeights(f.ctx, "node-b", "xn774", 100)
+	require.NoError(t, err)
+	require.Equal(t, int64(400), boosted)
+
+	// nodes and regions without rewards last epoch get the maximum boost
+	newcomer, err := f.keeper.ApplyRewardWeights(f.ctx, "node-c", "u4pru", 100)
+	require.NoError(t, err)
+	require.Equal(t, int64(400), newcomer)
+	newcomer, err = f.keeper.ApplyRewardWeights(f.ctx, "node-c", "", 100)
+	require.NoError(t, err)
+	require.Equal(t, int64(200), newcomer)
+
+	// an even epoch resets every weight to neutral
+	require.NoError(t, f.keeper.TallyReward(f.ctx, "node-a", "wydm9", "relayer-1", 100))
+	require.NoError(t, f.keeper.TallyReward(f.ctx, "node-b", "xn774", "relayer-2", 100))
+	require.NoError(t, f.keeper.RecordEntropy(f.ctx, 2))
+
+	weights, err = qs.RewardWeights(f.ctx, &types.QueryRewardWeightsRequest{Node: "node-b"})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyOneDec(), weights.NodeWeight)
+	newcomer, err = f.keeper.ApplyRewardWeights(f.ctx, "node-c", "u4pru", 100)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), newcomer)
+
+	history, err := qs.EntropyHistory(f.ctx, &types.QueryEntropyHistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, history.Snapshots, 2)
+	require.False(t, history.Snapshots[1].AdaptiveWeighting)
+}
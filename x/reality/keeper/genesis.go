@@ -3,13 +3,16 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
 	"contactical/x/reality/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
 	for _, elem := range genState.ClaimList {
-		if err := k.Claim.Set(ctx, elem.Id, elem); err != nil {
+		if err := k.SetClaim(ctx, elem); err != nil {
 			return err
 		}
 	}
@@ -20,7 +23,7 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 
 	// Set all the nodeInfo
 	for _, elem := range genState.NodeList {
-		if err := k.NodeInfo.Set(ctx, elem.Creator, elem); err != nil {
+		if err := k.SetNodeInfo(ctx, elem); err != nil {
 			return err
 		}
 	}
@@ -32,6 +35,92 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		}
 	}
 
+	// Set all the reputation checkpoints
+	for _, elem := range genState.ReputationHistory {
+		if err := k.ReputationHistory.Set(ctx, collections.Join(elem.Node, elem.EpochNumber), elem); err != nil {
+			return err
+		}
+	}
+
+	// Set all the banned nodes
+	for _, elem := range genState.BannedNodeList {
+		if err := k.BannedNodes.Set(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	// Set all the retired nodes
+	for _, elem := range genState.RetiredNodeList {
+		if err := k.RetiredNodes.Set(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	// Set all the entropy snapshots
+	for _, elem := range genState.EntropyHistory {
+		if err := k.EntropyHistory.Set(ctx, elem.EpochNumber, elem); err != nil {
+			return err
+		}
+	}
+
+	// Set all the reward tallies and weights
+	for _, elem := range genState.RewardTallies {
+		if err := k.RewardTally.Set(ctx, collections.Join(elem.Kind, elem.Key), elem.Amount); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.RewardWeights {
+		if err := k.RewardWeight.Set(ctx, collections.Join(elem.Kind, elem.Key), elem.Weight); err != nil {
+			return err
+		}
+	}
+
+	// Set all the vesting tranches
+	for _, elem := range genState.VestingTranches {
+		if err := k.VestingTranches.Set(ctx, collections.Join(elem.Node, elem.EpochIndex), elem); err != nil {
+			return err
+		}
+	}
+
+	if err := k.EmissionState.Set(ctx, genState.EmissionState.Normalize()); err != nil {
+		return err
+	}
+
+	// Set all the disputes and rebuild their indexes
+	for _, elem := range genState.DisputeList {
+		if err := k.SetDispute(ctx, elem); err != nil {
+			return err
+		}
+	}
+	if err := k.DisputeSeq.Set(ctx, genState.DisputeCount); err != nil {
+		return err
+	}
+
+	// Set all the pools
+	for _, elem := range genState.PoolList {
+		if err := k.SetPool(ctx, elem); err != nil {
+			return err
+		}
+	}
+	if err := k.PoolSeq.Set(ctx, genState.PoolCount); err != nil {
+		return err
+	}
+
+	// Set all the bonds and unbonding entries
+	for _, elem := range genState.Bonds {
+		if err := k.Bonds.Set(ctx, elem.Owner, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.UnbondingEntries {
+		if err := k.Unbondings.Set(ctx, collections.Join(elem.Owner, elem.CompletionTime), elem); err != nil {
+			return err
+		}
+		if err := k.UnbondingQueue.Set(ctx, collections.Join(elem.CompletionTime, elem.Owner)); err != nil {
+			return err
+		}
+	}
+
 	return k.Params.Set(ctx, genState.Params)
 }
 
@@ -75,5 +164,114 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	// Get all reputation checkpoints
+	err = k.ReputationHistory.Walk(ctx, nil, func(_ collections.Pair[string, int64], elem types.ReputationCheckpoint) (bool, error) {
+		genesis.ReputationHistory = append(genesis.ReputationHistory, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Get all banned nodes
+	err = k.BannedNodes.Walk(ctx, nil, func(key string) (bool, error) {
+		genesis.BannedNodeList = append(genesis.BannedNodeList, key)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Get all retired nodes
+	err = k.RetiredNodes.Walk(ctx, nil, func(key string) (bool, error) {
+		genesis.RetiredNodeList = append(genesis.RetiredNodeList, key)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Get all entropy snapshots
+	err = k.EntropyHistory.Walk(ctx, nil, func(_ int64, elem types.EntropySnapshot) (bool, error) {
+		genesis.EntropyHistory = append(genesis.EntropyHistory, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Get all reward tallies and weights
+	err = k.RewardTally.Walk(ctx, nil, func(key collections.Pair[string, string], amount int64) (bool, error) {
+		genesis.RewardTallies = append(genesis.RewardTallies, types.RewardTally{Kind: key.K1(), Key: key.K2(), Amount: amount})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.RewardWeight.Walk(ctx, nil, func(key collections.Pair[string, string], weight math.LegacyDec) (bool, error) {
+		genesis.RewardWeights = append(genesis.RewardWeights, types.RewardWeight{Kind: key.K1(), Key: key.K2(), Weight: weight})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Get all vesting tranches
+	err = k.VestingTranches.Walk(ctx, nil, func(_ collections.Pair[string, uint64], elem types.VestingTranche) (bool, error) {
+		genesis.VestingTranches = append(genesis.VestingTranches, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.EmissionState, err = k.GetEmissionState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get all disputes
+	err = k.Disputes.Walk(ctx, nil, func(_ uint64, elem types.Dispute) (bool, error) {
+		genesis.DisputeList = append(genesis.DisputeList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.DisputeCount, err = k.DisputeSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get all pools
+	err = k.Pools.Walk(ctx, nil, func(_ uint64, elem types.Pool) (bool, error) {
+		genesis.PoolList = append(genesis.PoolList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	genesis.PoolCount, err = k.PoolSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get all bonds and unbonding entries
+	err = k.Bonds.Walk(ctx, nil, func(_ string, elem types.Bond) (bool, error) {
+		genesis.Bonds = append(genesis.Bonds, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.Unbondings.Walk(ctx, nil, func(_ collections.Pair[string, int64], elem types.UnbondingEntry) (bool, error) {
+		genesis.UnbondingEntries = append(genesis.UnbondingEntries, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return genesis, nil
 }
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks wraps the Keeper to implement the x/epochs hooks.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the epoch hooks of the reality module.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterEpochEnd runs the reality epoch bookkeeping when the configured epoch ends.
+func (h Hooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if epochIdentifier != params.EpochIdentifier {
+		return nil
+	}
+
+	if err := h.k.CheckpointReputation(ctx, epochNumber); err != nil {
+		return err
+	}
+	// 분배는 엔트로피 기록이 epoch 집계를 초기화하기 전에 수행
+	if err := h.k.DistributeEpochRewards(ctx, epochNumber); err != nil {
+		return err
+	}
+	return h.k.RecordEntropy(ctx, epochNumber)
+}
+
+// BeforeEpochStart is a no-op.
+func (h Hooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
@@ -0,0 +1,304 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"contactical/x/reality/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Invariant routes of x/reality.
+const (
+	ClaimSequenceRoute  = "claim-sequence"
+	NodeNullifierRoute  = "node-nullifiers"
+	ClaimCreatorRoute   = "claim-creators"
+	ClaimIndexRoute     = "claim-indexes"
+	MintedRewardsRoute  = "minted-rewards"
+	RewardsAccountRoute = "rewards-account"
+	PoolAccountRoute    = "pool-account"
+	EscrowAccountRoute  = "escrow-account"
+)
+
+// invariants lists every x/reality invariant in the order they run.
+var invariants = []struct {
+	route     string
+	invariant func(Keeper) sdk.Invariant
+}{
+	{ClaimSequenceRoute, ClaimSequenceInvariant},
+	{NodeNullifierRoute, NodeNullifierInvariant},
+	{ClaimCreatorRoute, ClaimCreatorInvariant},
+	{ClaimIndexRoute, ClaimIndexInvariant},
+	{MintedRewardsRoute, MintedRewardsInvariant},
+	{RewardsAccountRoute, RewardsAccountInvariant},
+	{PoolAccountRoute, PoolAccountInvariant},
+	{EscrowAccountRoute, EscrowAccountInvariant},
+}
+
+// RegisterInvariants registers all x/reality invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for _, inv := range invariants {
+		ir.RegisterRoute(types.ModuleName, inv.route, inv.invariant(k))
+	}
+}
+
+// AllInvariants runs all x/reality invariants and returns the first broken one.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range invariants {
+			if res, stop := inv.invariant(k)(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// CheckInvariants runs the invariant of route, or all of them if route is
+// empty, and reports each outcome.
+func (k Keeper) CheckInvariants(ctx sdk.Context, route string) ([]types.InvariantResult, error) {
+	var results []types.InvariantResult
+	for _, inv := range invariants {
+		if route != "" && route != inv.route {
+			continue
+		}
+		msg, broken := inv.invariant(k)(ctx)
+		results = append(results, types.InvariantResult{Route: inv.route, Broken: broken, Message: msg})
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("unknown invariant route %q", route)
+	}
+	return results, nil
+}
+
+// ClaimSequenceInvariant checks that ClaimSeq is ahead of every stored claim id.
+func ClaimSequenceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		next, err := k.ClaimSeq.Peek(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, ClaimSequenceRoute, fmt.Sprintf("failed to read claim sequence: %s", err)), true
+		}
+		var ahead []uint64
+		err = k.Claim.Walk(ctx, new(collections.Range[uint64]).StartInclusive(next), func(id uint64, _ types.Claim) (bool, error) {
+			ahead = append(ahead, id)
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, ClaimSequenceRoute, fmt.Sprintf("failed to walk claims: %s", err)), true
+		}
+		return sdk.FormatInvariant(types.ModuleName, ClaimSequenceRoute,
+			fmt.Sprintf("claim sequence %d, %d claims at or past it: %v", next, len(ahead), ahead)), len(ahead) > 0
+	}
+}
+
+// NodeNullifierInvariant checks that the nullifier of every ZK registered
+// node is consumed.
+func NodeNullifierInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var missing []string
+		err := k.NodeInfo.Walk(ctx, nil, func(creator string, node types.NodeInfo) (bool, error) {
+			if node.Nullifier == "" {
+				return false, nil
+			}
+			has, err := k.Nullifiers.Has(ctx, node.Nullifier)
+			if err != nil {
+				return true, err
+			}
+			if !has {
+				missing = append(missing, creator)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, NodeNullifierRoute, fmt.Sprintf("failed to walk nodes: %s", err)), true
+		}
+		return sdk.FormatInvariant(types.ModuleName, NodeNullifierRoute,
+			fmt.Sprintf("%d nodes with an unconsumed nullifier: %v", len(missing), missing)), len(missing) > 0
+	}
+}
+
+// ClaimCreatorInvariant checks that every claim was created by a registered
+// node, or by a node that has since been removed.
+func ClaimCreatorInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var orphans []uint64
+		err := k.Claim.Walk(ctx, nil, func(id uint64, claim types.Claim) (bool, error) {
+			registered, err := k.NodeInfo.Has(ctx, claim.Creator)
+			if err != nil {
+				return true, err
+			}
+			retired, err := k.RetiredNodes.Has(ctx, claim.Creator)
+			if err != nil {
+				return true, err
+			}
+			if !registered && !retired {
+				orphans = append(orphans, id)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, ClaimCreatorRoute, fmt.Sprintf("failed to walk claims: %s", err)), true
+		}
+		return sdk.FormatInvariant(types.ModuleName, ClaimCreatorRoute,
+			fmt.Sprintf("%d claims by an unknown node: %v", len(orphans), orphans)), len(orphans) > 0
+	}
+}
+
+// ClaimIndexInvariant checks that the time and creator indexes hold exactly
+// one entry per stored claim.
+func ClaimIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var unindexed []uint64
+		var claims int
+		err := k.Claim.Walk(ctx, nil, func(id uint64, claim types.Claim) (bool, error) {
+			claims++
+			byTime, err := k.ClaimTimeIndex.Has(ctx, collections.Join(claim.BlockTime, id))
+			if err != nil {
+				return true, err
+			}
+			byCreator, err := k.ClaimCreatorIndex.Has(ctx, collections.Join(claim.Creator, id))
+			if err != nil {
+				return true, err
+			}
+			if !byTime || !byCreator {
+				unindexed = append(unindexed, id)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, ClaimIndexRoute, fmt.Sprintf("failed to walk claims: %s", err)), true
+		}
+
+		// 색인된 클레임이 모두 존재하면 항목 수가 클레임 수와 같아야 함
+		var timeEntries, creatorEntries int
+		if err := k.ClaimTimeIndex.Walk(ctx, nil, func(collections.Pair[int64, uint64]) (bool, error) {
+			timeEntries++
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, ClaimIndexRoute, fmt.Sprintf("failed to walk time index: %s", err)), true
+		}
+		if err := k.ClaimCreatorIndex.Walk(ctx, nil, func(collections.Pair[string, uint64]) (bool, error) {
+			creatorEntries++
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, ClaimIndexRoute, fmt.Sprintf("failed to walk creator index: %s", err)), true
+		}
+
+		broken := len(unindexed) > 0 || timeEntries != claims || creatorEntries != claims
+		return sdk.FormatInvariant(types.ModuleName, ClaimIndexRoute,
+			fmt.Sprintf("%d claims, %d time index entries, %d creator index entries, unindexed claims: %v",
+				claims, timeEntries, creatorEntries, unindexed)), broken
+	}
+}
+
+// MintedRewardsInvariant checks the emission totals against the bank: the
+// rewards account holds what was minted and neither withdrawn nor clawed
+// back. The supply of the reward denom is not checked, since other modules
+// such as x/mint and bond slashing also mint and burn it.
+func MintedRewardsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, MintedRewardsRoute, fmt.Sprintf("failed to read params: %s", err)), true
+		}
+		state, err := k.GetEmissionState(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, MintedRewardsRoute, fmt.Sprintf("failed to read emission state: %s", err)), true
+		}
+
+		denom := params.EpochEmission.Denom
+		outstanding := state.TotalMinted.Sub(state.TotalClawedBack)
+		held := outstanding.Sub(state.TotalWithdrawn)
+		balance := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.RewardsAccountName), denom).Amount
+
+		broken := !balance.Equal(held)
+		return sdk.FormatInvariant(types.ModuleName, MintedRewardsRoute,
+			fmt.Sprintf("minted %s, withdrawn %s, clawed back %s; rewards account %s%s",
+				state.TotalMinted, state.TotalWithdrawn, state.TotalClawedBack, balance, denom)), broken
+	}
+}
+
+// RewardsAccountInvariant checks that the rewards account holds exactly the
+// rewards escrowed in vesting tranches.
+func RewardsAccountInvariant(k Keeper) sdk.Invariant {
+	return k.accountInvariant(RewardsAccountRoute, types.RewardsAccountName, k.RewardsHeld)
+}
+
+// PoolAccountInvariant checks that the pool account holds exactly the pool
+// reserves.
+func PoolAccountInvariant(k Keeper) sdk.Invariant {
+	return k.accountInvariant(PoolAccountRoute, types.PoolAccountName, k.PoolReserves)
+}
+
+// EscrowAccountInvariant checks that the escrow account holds exactly the
+// bonds, unbonding entries and dispute bonds.
+func EscrowAccountInvariant(k Keeper) sdk.Invariant {
+	return k.accountInvariant(EscrowAccountRoute, types.EscrowAccountName, k.EscrowHeld)
+}
+
+// accountInvariant compares the balance of a module account with the amount
+// its bookkeeping says it holds.
+func (k Keeper) accountInvariant(route, account string, held func(context.Context) (sdk.Coins, error)) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected, err := held(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, route, fmt.Sprintf("failed to read bookkeeping: %s", err)), true
+		}
+		balance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(account))
+		broken := !balance.Equal(expected)
+		return sdk.FormatInvariant(types.ModuleName, route,
+			fmt.Sprintf("%s balance %s, bookkeeping %s", account, balance, expected)), broken
+	}
+}
+
+// RewardsHeld returns the rewards escrowed in every vesting tranche.
+func (k Keeper) RewardsHeld(ctx context.Context) (sdk.Coins, error) {
+	held := sdk.NewCoins()
+	err := k.VestingTranches.Walk(ctx, nil, func(_ collections.Pair[string, uint64], tranche types.VestingTranche) (bool, error) {
+		held = held.Add(tranche.Held()...)
+		return false, nil
+	})
+	return held, err
+}
+
+// PoolReserves returns the reserves of every pool.
+func (k Keeper) PoolReserves(ctx context.Context) (sdk.Coins, error) {
+	reserves := sdk.NewCoins()
+	err := k.Pools.Walk(ctx, nil, func(_ uint64, pool types.Pool) (bool, error) {
+		reserves = reserves.Add(pool.Reserves()...)
+		return false, nil
+	})
+	return reserves, err
+}
+
+// EscrowHeld returns the bonds, unbonding entries and the bonds of unresolved
+// disputes.
+func (k Keeper) EscrowHeld(ctx context.Context) (sdk.Coins, error) {
+	held := sdk.NewCoins()
+	err := k.Bonds.Walk(ctx, nil, func(_ string, bond types.Bond) (bool, error) {
+		held = held.Add(bond.Amount)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.Unbondings.Walk(ctx, nil, func(_ collections.Pair[string, int64], entry types.UnbondingEntry) (bool, error) {
+		held = held.Add(entry.Amount)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.ActiveDisputes.Walk(ctx, nil, func(_ uint64, disputeID uint64) (bool, error) {
+		dispute, err := k.Disputes.Get(ctx, disputeID)
+		if err != nil {
+			return true, err
+		}
+		held = held.Add(dispute.Bond)
+		return false, nil
+	})
+	return held, err
+}
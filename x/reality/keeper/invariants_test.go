@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"contactical/x/reality/keeper"
+	"contactical/x/reality/types"
+)
+
+func TestModuleAccountInvariants(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, node, challenger, claimID := setupDispute(t, f)
+
+	requireIntact := func() {
+		t.Helper()
+		res, broken := keeper.AllInvariants(f.keeper)(ctx)
+		require.False(t, broken, res)
+	}
+	requireIntact()
+
+	// rewards: minted into tranches, partly withdrawn
+	require.NoError(t, f.keeper.TallyReward(ctx, node, "wydm9", node, 100))
+	require.NoError(t, f.keeper.DistributeEpochRewards(ctx, 1))
+	requireIntact()
+	_, err := f.keeper.WithdrawVestedRewards(ctx.WithBlockTime(ctx.BlockTime().Add(1_000_000_000)), node, sdk.MustAccAddressFromBech32(node))
+	require.NoError(t, err)
+	requireIntact()
+
+	// escrow: bond, unbonding entry and dispute bond
+	_, err = f.keeper.AddBond(ctx, node, fundBond(t, f, node, 2))
+	require.NoError(t, err)
+	_, err = ms.Unbond(ctx, &types.MsgUnbond{Creator: node, Amount: sdk.NewInt64Coin(types.BondDenom, 1_000)})
+	require.NoError(t, err)
+	_, err = ms.ChallengeClaim(ctx, &types.MsgChallengeClaim{Creator: challenger, ClaimId: claimID, Bond: sdk.NewInt64Coin(types.DefaultRewardDenom, 200), Reason: "spoofed gnss"})
+	require.NoError(t, err)
+	requireIntact()
+
+	// pool: reserves move with swaps
+	lp := sdk.AccAddress([]byte("invariant_lp________")).String()
+	fund(t, f, lp, sdk.NewCoins(sdk.NewInt64Coin("stake", 20_000), sdk.NewInt64Coin("token", 10_000)))
+	_, err = ms.CreatePool(ctx, &types.MsgCreatePool{Creator: lp, TokenA: sdk.NewInt64Coin("stake", 10_000), TokenB: sdk.NewInt64Coin("token", 10_000)})
+	require.NoError(t, err)
+	_, err = ms.Swap(ctx, &types.MsgSwap{Creator: lp, AmountIn: "1000stake", TargetDenom: "token"})
+	require.NoError(t, err)
+	requireIntact()
+
+	// each account is checked on its own
+	for _, tc := range []struct {
+		account   string
+		invariant sdk.Invariant
+	}{
+		{types.RewardsAccountName, keeper.RewardsAccountInvariant(f.keeper)},
+		{types.PoolAccountName, keeper.PoolAccountInvariant(f.keeper)},
+		{types.EscrowAccountName, keeper.EscrowAccountInvariant(f.keeper)},
+	} {
+		_, broken := tc.invariant(ctx)
+		require.False(t, broken, tc.account)
+
+		stray := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+		require.NoError(t, f.bankKeeper.send(lp, moduleAddr(tc.account), stray))
+		_, broken = tc.invariant(ctx)
+		require.True(t, broken, tc.account)
+		require.NoError(t, f.bankKeeper.send(moduleAddr(tc.account), lp, stray))
+	}
+	requireIntact()
+}
+
+func TestStateInvariants(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx, node, _, claimID := setupDispute(t, f)
+
+	check := func(route string) bool {
+		t.Helper()
+		resp, err := qs.Invariants(ctx, &types.QueryInvariantsRequest{Route: route})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 1)
+		return resp.Broken
+	}
+	all, err := qs.Invariants(ctx, &types.QueryInvariantsRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Results, 8)
+	require.False(t, all.Broken)
+	_, err = qs.Invariants(ctx, &types.QueryInvariantsRequest{Route: "unknown"})
+	require.Error(t, err)
+
+	// claims of a retired node stay attributable
+	_, err = ms.RetireNode(ctx, &types.MsgRetireNode{Creator: node})
+	require.NoError(t, err)
+	require.False(t, check(keeper.ClaimCreatorRoute))
+	unknown := sdk.AccAddress([]byte("unknown_node________")).String()
+	orphan, err := f.keeper.AppendClaim(ctx, types.Claim{Creator: unknown})
+	require.NoError(t, err)
+	require.True(t, check(keeper.ClaimCreatorRoute))
+	require.False(t, check(keeper.ClaimIndexRoute))
+	require.NoError(t, f.keeper.Claim.Remove(ctx, orphan))
+
+	// index entries left behind by a removed claim
+	require.True(t, check(keeper.ClaimIndexRoute))
+	require.NoError(t, f.keeper.ClaimTimeIndex.Remove(ctx, collections.Join(ctx.BlockTime().Unix(), orphan)))
+	require.NoError(t, f.keeper.ClaimCreatorIndex.Remove(ctx, collections.Join(unknown, orphan)))
+	require.False(t, check(keeper.ClaimIndexRoute))
+
+	// a claim stored past the sequence
+	require.NoError(t, f.keeper.Claim.Set(ctx, claimID+5, types.Claim{Id: claimID + 5, Creator: node}))
+	require.True(t, check(keeper.ClaimSequenceRoute))
+	require.NoError(t, f.keeper.Claim.Remove(ctx, claimID+5))
+	require.False(t, check(keeper.ClaimSequenceRoute))
+
+	// a ZK node whose nullifier was never consumed
+	zkNode := sdk.AccAddress([]byte("zk_node_____________")).String()
+	require.NoError(t, f.keeper.SetNodeInfo(ctx, types.NodeInfo{Creator: zkNode, Nullifier: "n-1"}))
+	require.True(t, check(keeper.NodeNullifierRoute))
+	require.NoError(t, f.keeper.Nullifiers.Set(ctx, "n-1"))
+	require.False(t, check(keeper.NodeNullifierRoute))
+
+	// rewards minted outside the emission bookkeeping
+	require.NoError(t, f.keeper.TallyReward(ctx, zkNode, "wydm9", zkNode, 100))
+	require.NoError(t, f.keeper.DistributeEpochRewards(ctx, 1))
+	require.False(t, check(keeper.MintedRewardsRoute))
+	require.NoError(t, f.bankKeeper.MintCoins(ctx, types.RewardsAccountName, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 1))))
+	require.True(t, check(keeper.MintedRewardsRoute))
+	require.True(t, check(keeper.RewardsAccountRoute))
+}
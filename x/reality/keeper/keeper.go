@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -26,11 +27,61 @@ type Keeper struct {
 
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
+	nftKeeper     types.NFTKeeper
 	ClaimSeq      collections.Sequence
 	Claim         collections.Map[uint64, types.Claim]
 	NodeInfo      collections.Map[string, types.NodeInfo]
 	Nullifiers    collections.KeySet[string]
 
+	// ClaimTimeIndex orders claims by (block time, claim id).
+	ClaimTimeIndex collections.KeySet[collections.Pair[int64, uint64]]
+	// ClaimCreatorIndex groups claims by (creator, claim id).
+	ClaimCreatorIndex collections.KeySet[collections.Pair[string, uint64]]
+
+	// ReputationIndex orders nodes by (reputation, creator).
+	ReputationIndex collections.KeySet[collections.Pair[int64, string]]
+	// RegionIndex orders nodes by (region, (reputation, creator)).
+	RegionIndex collections.KeySet[collections.Pair[string, collections.Pair[int64, string]]]
+	// ReputationHistory stores per-epoch checkpoints keyed by (creator, epoch number).
+	ReputationHistory collections.Map[collections.Pair[string, int64], types.ReputationCheckpoint]
+	// BannedNodes holds node addresses banned by governance.
+	BannedNodes collections.KeySet[string]
+	// RetiredNodes holds removed node addresses so their claims stay attributable.
+	RetiredNodes collections.KeySet[string]
+
+	// RewardTally accumulates the rewards of the epoch in progress keyed by (kind, key).
+	RewardTally collections.Map[collections.Pair[string, string], int64]
+	// RewardWeight holds the adaptive reward weights keyed by (kind, key). The
+	// empty key holds the weight of participants without a tally.
+	RewardWeight collections.Map[collections.Pair[string, string], math.LegacyDec]
+	// EntropyHistory stores the entropy snapshot of every finished epoch.
+	EntropyHistory collections.Map[int64, types.EntropySnapshot]
+	// VestingTranches holds escrowed epoch rewards keyed by (node, epoch index).
+	VestingTranches collections.Map[collections.Pair[string, uint64], types.VestingTranche]
+	// EmissionState tracks the halving schedule and the cumulative minted supply.
+	EmissionState collections.Item[types.EmissionState]
+
+	DisputeSeq collections.Sequence
+	Disputes   collections.Map[uint64, types.Dispute]
+	// ActiveDisputes maps a claim id to its unresolved dispute.
+	ActiveDisputes collections.Map[uint64, uint64]
+	// ClaimDisputes indexes every dispute by (claim id, dispute id).
+	ClaimDisputes collections.KeySet[collections.Pair[uint64, uint64]]
+	// DisputeDeadlines queues active disputes by (deadline, dispute id).
+	DisputeDeadlines collections.KeySet[collections.Pair[int64, uint64]]
+
+	// Bonds holds the node and relayer bonds keyed by owner.
+	Bonds collections.Map[string, types.Bond]
+	// Unbondings holds the bonds being released keyed by (owner, completion time).
+	Unbondings collections.Map[collections.Pair[string, int64], types.UnbondingEntry]
+	// UnbondingQueue orders the unbondings by (completion time, owner).
+	UnbondingQueue collections.KeySet[collections.Pair[int64, string]]
+
+	PoolSeq collections.Sequence
+	Pools   collections.Map[uint64, types.Pool]
+	// PoolByDenoms maps a sorted denom pair to its pool id.
+	PoolByDenoms collections.Map[collections.Pair[string, string], uint64]
+
 	// [New] Plugin Registry
 	verifiers []Verifier
 }
@@ -51,6 +102,7 @@ func NewKeeper(
 
 	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
+	nftKeeper types.NFTKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -65,12 +117,51 @@ func NewKeeper(
 		authority:     authority,
 		bankKeeper:    bankKeeper,
 		stakingKeeper: stakingKeeper,
+		nftKeeper:     nftKeeper,
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		Claim:         collections.NewMap(sb, types.ClaimKey, "claim", collections.Uint64Key, codec.CollValue[types.Claim](cdc)),
 		ClaimSeq:      collections.NewSequence(sb, types.ClaimCountKey, "claimSequence"),
 		NodeInfo:      collections.NewMap(sb, types.NodeInfoKey, "nodeInfo", collections.StringKey, codec.CollValue[types.NodeInfo](cdc)),
 		Nullifiers:    collections.NewKeySet(sb, types.NullifierKey, "nullifiers", collections.StringKey),
-		verifiers:     []Verifier{},
+		ClaimTimeIndex: collections.NewKeySet(sb, types.ClaimTimeIndexKey, "claimTimeIndex",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		ClaimCreatorIndex: collections.NewKeySet(sb, types.ClaimCreatorIndexKey, "claimCreatorIndex",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		ReputationIndex: collections.NewKeySet(sb, types.ReputationIndexKey, "reputationIndex",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey)),
+		RegionIndex: collections.NewKeySet(sb, types.RegionIndexKey, "regionIndex",
+			collections.PairKeyCodec(collections.StringKey, collections.PairKeyCodec(collections.Int64Key, collections.StringKey))),
+		ReputationHistory: collections.NewMap(sb, types.ReputationHistoryKey, "reputationHistory",
+			collections.PairKeyCodec(collections.StringKey, collections.Int64Key), codec.CollValue[types.ReputationCheckpoint](cdc)),
+		BannedNodes: collections.NewKeySet(sb, types.BannedNodeKey, "bannedNodes", collections.StringKey),
+		RetiredNodes: collections.NewKeySet(sb, types.RetiredNodeKey, "retiredNodes", collections.StringKey),
+		RewardTally: collections.NewMap(sb, types.RewardTallyKey, "rewardTally",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Int64Value),
+		RewardWeight: collections.NewMap(sb, types.RewardWeightKey, "rewardWeight",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), sdk.LegacyDecValue),
+		EntropyHistory: collections.NewMap(sb, types.EntropyHistoryKey, "entropyHistory",
+			collections.Int64Key, codec.CollValue[types.EntropySnapshot](cdc)),
+		VestingTranches: collections.NewMap(sb, types.VestingTrancheKey, "vestingTranches",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.VestingTranche](cdc)),
+		EmissionState: collections.NewItem(sb, types.EmissionStateKey, "emissionState", codec.CollValue[types.EmissionState](cdc)),
+		DisputeSeq:    collections.NewSequence(sb, types.DisputeCountKey, "disputeSequence"),
+		Disputes:      collections.NewMap(sb, types.DisputeKey, "disputes", collections.Uint64Key, codec.CollValue[types.Dispute](cdc)),
+		ActiveDisputes: collections.NewMap(sb, types.ActiveDisputeKey, "activeDisputes",
+			collections.Uint64Key, collections.Uint64Value),
+		ClaimDisputes: collections.NewKeySet(sb, types.ClaimDisputeKey, "claimDisputes",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		DisputeDeadlines: collections.NewKeySet(sb, types.DisputeDeadlineKey, "disputeDeadlines",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		Bonds: collections.NewMap(sb, types.BondKey, "bonds", collections.StringKey, codec.CollValue[types.Bond](cdc)),
+		Unbondings: collections.NewMap(sb, types.UnbondingKey, "unbondings",
+			collections.PairKeyCodec(collections.StringKey, collections.Int64Key), codec.CollValue[types.UnbondingEntry](cdc)),
+		UnbondingQueue: collections.NewKeySet(sb, types.UnbondingQueueKey, "unbondingQueue",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey)),
+		PoolSeq: collections.NewSequence(sb, types.PoolCountKey, "poolSequence"),
+		Pools:   collections.NewMap(sb, types.PoolKey, "pools", collections.Uint64Key, codec.CollValue[types.Pool](cdc)),
+		PoolByDenoms: collections.NewMap(sb, types.PoolDenomsKey, "poolByDenoms",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Value),
+		verifiers: []Verifier{},
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -6,12 +6,14 @@ import (
 
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"contactical/x/reality/keeper"
 	module "contactical/x/reality/module"
@@ -20,8 +22,11 @@ import (
 
 type fixture struct {
 	ctx          context.Context
+	storeKey     *storetypes.KVStoreKey
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	bankKeeper   *mockBankKeeper
+	nftKeeper    *mockNFTKeeper
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,14 +40,17 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	bankKeeper := newMockBankKeeper()
+	nftKeeper := newMockNFTKeeper()
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		bankKeeper,
 		nil,
-		nil,
+		nftKeeper,
 	)
 
 	// Initialize params
@@ -52,7 +60,28 @@ func initFixture(t *testing.T) *fixture {
 
 	return &fixture{
 		ctx:          ctx,
+		storeKey:     storeKey,
 		keeper:       k,
 		addressCodec: addressCodec,
+		bankKeeper:   bankKeeper,
+		nftKeeper:    nftKeeper,
+	}
+}
+
+// lastEvent returns the last typed event of the given type emitted in ctx.
+func lastEvent[T proto.Message](t *testing.T, ctx context.Context) T {
+	t.Helper()
+	events := sdk.UnwrapSDKContext(ctx).EventManager().Events()
+	for i := len(events) - 1; i >= 0; i-- {
+		msg, err := sdk.ParseTypedEvent(abci.Event(events[i]))
+		if err != nil {
+			continue
+		}
+		if event, ok := msg.(T); ok {
+			return event
+		}
 	}
+	var zero T
+	t.Fatalf("no %T event emitted", zero)
+	return zero
 }
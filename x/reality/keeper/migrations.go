@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"fmt"
+
+	"contactical/x/reality/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator migrates the module state between consensus versions.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator for the keeper.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate1to2 sets the params added since version 1 to their defaults and
+// builds the claim indexes by block time and creator and the node indexes by
+// reputation and region, which version 1 did not keep. Claims stored by
+// version 1 carry no block time and are indexed at time 0.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+	if err := m.migrateParams(ctx); err != nil {
+		return err
+	}
+	if err := k.Claim.Walk(ctx, nil, func(id uint64, claim types.Claim) (bool, error) {
+		if err := k.ClaimTimeIndex.Set(ctx, collections.Join(claim.BlockTime, id)); err != nil {
+			return true, err
+		}
+		return false, k.ClaimCreatorIndex.Set(ctx, collections.Join(claim.Creator, id))
+	}); err != nil {
+		return err
+	}
+	return k.NodeInfo.Walk(ctx, nil, func(creator string, node types.NodeInfo) (bool, error) {
+		if err := k.ReputationIndex.Set(ctx, collections.Join(node.Reputation, creator)); err != nil {
+			return true, err
+		}
+		if node.Region == "" {
+			return false, nil
+		}
+		return false, k.RegionIndex.Set(ctx, collections.Join(node.Region, collections.Join(node.Reputation, creator)))
+	})
+}
+
+// migrateParams fills the params missing from version 1 params with their
+// default values. Version 1 could not set any of them, so zero values whose
+// meaning is valid, like no halving, are replaced as well.
+func (m Migrator) migrateParams(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	def := types.DefaultParams()
+	if params.EpochIdentifier == "" {
+		params.EpochIdentifier = def.EpochIdentifier
+	}
+	if params.RegionGeohashPrecision == 0 {
+		params.RegionGeohashPrecision = def.RegionGeohashPrecision
+	}
+	if params.EntropyTarget.IsNil() {
+		params.EntropyTarget = def.EntropyTarget
+	}
+	if params.MaxRewardBoost.IsNil() {
+		params.MaxRewardBoost = def.MaxRewardBoost
+	}
+	if params.EpochEmission.Denom == "" {
+		params.EpochEmission = def.EpochEmission
+	}
+	if params.HalvingInterval == 0 {
+		params.HalvingInterval = def.HalvingInterval
+	}
+	if params.MaxTotalEmission.IsNil() {
+		params.MaxTotalEmission = def.MaxTotalEmission
+	}
+	if params.VestingPeriod == 0 {
+		params.VestingPeriod = def.VestingPeriod
+	}
+	if params.DisputeMinBond.Denom == "" {
+		params.DisputeMinBond = def.DisputeMinBond
+	}
+	if params.DisputeResponseWindow == 0 {
+		params.DisputeResponseWindow = def.DisputeResponseWindow
+	}
+	if params.DisputeResolutionWindow == 0 {
+		params.DisputeResolutionWindow = def.DisputeResolutionWindow
+	}
+	if params.DisputeJury == "" {
+		params.DisputeJury = def.DisputeJury
+	}
+	if params.DisputeSlashFraction.IsNil() {
+		params.DisputeSlashFraction = def.DisputeSlashFraction
+	}
+	if params.DisputeReputationPenalty == 0 {
+		params.DisputeReputationPenalty = def.DisputeReputationPenalty
+	}
+	if len(params.TierBonds) == 0 {
+		params.TierBonds = def.TierBonds
+	}
+	if params.UnbondingPeriod == 0 {
+		params.UnbondingPeriod = def.UnbondingPeriod
+	}
+	if params.BondSlashDispute.IsNil() {
+		params.BondSlashDispute = def.BondSlashDispute
+	}
+	if params.BondSlashRevocation.IsNil() {
+		params.BondSlashRevocation = def.BondSlashRevocation
+	}
+	if params.BondSlashBan.IsNil() {
+		params.BondSlashBan = def.BondSlashBan
+	}
+	if params.SwapFee.IsNil() {
+		params.SwapFee = def.SwapFee
+	}
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid migrated params: %w", err)
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
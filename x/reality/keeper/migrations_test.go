@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"contactical/x/reality/keeper"
+	"contactical/x/reality/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// 버전 1 상태: 처음 네 필드만 있는 파라미터와 인덱스 없이 저장된 클레임과 노드
+	v1 := types.Params{RewardBaseUnit: 500, MaxTrustScore: 90, MinScoreThreshold: 5, SecurityWeights: map[string]int32{"tee": 40}}
+	ctx.KVStore(f.storeKey).Set(types.ParamsKey, v1Params(t, v1))
+	stored, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, stored.MaxRewardBoost.IsNil())
+	require.Error(t, stored.Validate())
+	alice := sdk.AccAddress([]byte("migration_alice_____")).String()
+	bob := sdk.AccAddress([]byte("migration_bob_______")).String()
+	require.NoError(t, f.keeper.Claim.Set(ctx, 1, types.Claim{Id: 1, Creator: alice}))
+	require.NoError(t, f.keeper.Claim.Set(ctx, 2, types.Claim{Id: 2, Creator: bob, BlockTime: 1_700_000_000}))
+	require.NoError(t, f.keeper.NodeInfo.Set(ctx, alice, types.NodeInfo{Creator: alice, Reputation: 40, Region: "wydm9"}))
+	require.NoError(t, f.keeper.NodeInfo.Set(ctx, bob, types.NodeInfo{Creator: bob, Reputation: 70}))
+	_, broken := keeper.ClaimIndexInvariant(f.keeper)(ctx)
+	require.True(t, broken)
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	// 버전 1 값은 유지되고 나머지는 기본값으로 채워짐
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, params.Validate())
+	want := types.DefaultParams()
+	want.RewardBaseUnit, want.MaxTrustScore, want.MinScoreThreshold, want.SecurityWeights = 500, 90, 5, map[string]int32{"tee": 40}
+	require.Equal(t, want, params)
+
+	res, broken := keeper.ClaimIndexInvariant(f.keeper)(ctx)
+	require.False(t, broken, res)
+	for _, key := range []collections.Pair[int64, uint64]{collections.Join(int64(0), uint64(1)), collections.Join(int64(1_700_000_000), uint64(2))} {
+		has, err := f.keeper.ClaimTimeIndex.Has(ctx, key)
+		require.NoError(t, err)
+		require.True(t, has)
+	}
+	has, err := f.keeper.ClaimCreatorIndex.Has(ctx, collections.Join(bob, uint64(2)))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	for _, key := range []collections.Pair[int64, string]{collections.Join(int64(40), alice), collections.Join(int64(70), bob)} {
+		has, err := f.keeper.ReputationIndex.Has(ctx, key)
+		require.NoError(t, err)
+		require.True(t, has)
+	}
+	has, err = f.keeper.RegionIndex.Has(ctx, collections.Join("wydm9", collections.Join(int64(40), alice)))
+	require.NoError(t, err)
+	require.True(t, has)
+	// 지역이 없는 노드는 지역 인덱스에 들어가지 않음
+	var regions int
+	require.NoError(t, f.keeper.RegionIndex.Walk(ctx, nil, func(collections.Pair[string, collections.Pair[int64, string]]) (bool, error) {
+		regions++
+		return false, nil
+	}))
+	require.Equal(t, 1, regions)
+}
+
+// v1Params returns the encoding of params as stored by version 1, which only
+// had the first four fields.
+func v1Params(t *testing.T, params types.Params) []byte {
+	t.Helper()
+	bz, err := params.Marshal()
+	require.NoError(t, err)
+	var v1 []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.GreaterOrEqual(t, n, 0)
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		require.GreaterOrEqual(t, m, 0)
+		if num <= 4 {
+			v1 = append(v1, bz[:n+m]...)
+		}
+		bz = bz[n+m:]
+	}
+	return v1
+}
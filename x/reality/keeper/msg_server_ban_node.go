@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"contactical/x/reality/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) BanNode(goCtx context.Context, msg *types.MsgBanNode) (*types.MsgBanNodeResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.removeSanctionedNode(ctx, msg.Node); err != nil {
+		return nil, err
+	}
+	if err := k.BannedNodes.Set(ctx, msg.Node); err != nil {
+		return nil, err
+	}
+	clawback, err := k.ClawbackNode(ctx, msg.Node)
+	if err != nil {
+		return nil, err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slashed, err := k.SlashBond(ctx, msg.Node, params.BondSlashBan, "ban")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := k.UnbondAll(ctx, msg.Node); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNodeBanned{
+		NodeId:      msg.Node,
+		Reason:      msg.Reason,
+		Clawback:    clawback,
+		Slashed:     slashed,
+		BlockHeight: ctx.BlockHeight(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBanNodeResponse{}, nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"contactical/x/reality/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ChallengeClaim(goCtx context.Context, msg *types.MsgChallengeClaim) (*types.MsgChallengeClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	dispute, err := k.OpenDispute(ctx, msg.Creator, msg.ClaimId, msg.Bond, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDisputeOpened{
+		DisputeId:        dispute.Id,
+		ClaimId:          dispute.ClaimId,
+		Challenger:       dispute.Challenger,
+		NodeId:           dispute.Node,
+		Bond:             dispute.Bond,
+		ResponseDeadline: dispute.ResponseDeadline,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChallengeClaimResponse{DisputeId: dispute.Id}, nil
+}
@@ -12,6 +12,7 @@ import (
 
 	"contactical/x/reality/types"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -22,7 +23,7 @@ func (k msgServer) CreateClaim(goCtx context.Context, msg *types.MsgCreateClaim)
 	// (Proxy가 대신 Tx를 보낼 경우 msg.Creator는 Proxy 주소가 되므로, 실제 단말 ID인 msg.NodeId를 사용해야 함)
 	nodeInfo, err := k.NodeInfo.Get(ctx, msg.NodeId)
 	if err != nil {
-		return nil, fmt.Errorf("등록되지 않은 노드(기기)입니다. NodeId=%s: %w", msg.NodeId, err)
+		return nil, errorsmod.Wrapf(types.ErrUnknownNode, "node id %s", msg.NodeId)
 	}
 
 	// 파라미터 조회
@@ -66,17 +67,17 @@ func (k msgServer) CreateClaim(goCtx context.Context, msg *types.MsgCreateClaim)
 		validityWindow := int64(120)
 
 		if msg.Timestamp < blockTime-validityWindow {
-			return nil, fmt.Errorf("메시지 만료됨 (재전송 공격 의심): timestamp %d < current %d", msg.Timestamp, blockTime)
+			return nil, errorsmod.Wrapf(types.ErrExpiredTimestamp, "timestamp %d < current %d", msg.Timestamp, blockTime)
 		}
 		if msg.Timestamp > blockTime+validityWindow {
-			return nil, fmt.Errorf("미래의 시간 메시지: timestamp %d > current %d", msg.Timestamp, blockTime)
+			return nil, errorsmod.Wrapf(types.ErrExpiredTimestamp, "timestamp %d > current %d", msg.Timestamp, blockTime)
 		}
 
 		// 2. TEE 인증서 검증 (Cert가 있을 경우만)
 		if msg.Cert != "" {
 			attResult, err = k.ParseAndVerifyTEE(msg.Cert)
 			if err != nil {
-				return nil, fmt.Errorf("TEE security verification failed: %w", err)
+				return nil, errorsmod.Wrapf(types.ErrAttestationFailed, "TEE: %v", err)
 			}
 		} else {
 			// Cert가 없으면 기존 정보 기반으로 최소한의 검증만 수행하거나 패스
@@ -86,7 +87,7 @@ func (k msgServer) CreateClaim(goCtx context.Context, msg *types.MsgCreateClaim)
 		// 3. [데이터 무결성 검증] 기기 서명 검증 (Payload)
 		// 안드로이드가 서명한 원본 데이터(Payload)와 서명(DataSignature)을 대조
 		if !VerifyDeviceSignature(nodeInfo.PubKey, []byte(msg.Payload), msg.DataSignature) {
-			return nil, fmt.Errorf("데이터 서명 검증 실패: 기기 키와 일치하지 않음 (위변조 감지)")
+			return nil, errorsmod.Wrapf(types.ErrInvalidSignature, "node id %s", msg.NodeId)
 		}
 	}
 
@@ -115,7 +116,7 @@ func (k msgServer) CreateClaim(goCtx context.Context, msg *types.MsgCreateClaim)
 		if v.CanVerify(msg.ExtraAttestation) {
 			// 실제 검증 수행 (실패 시 Tx 거부)
 			if err := v.Verify(ctx, msg); err != nil {
-				return nil, fmt.Errorf("security check failed by plugin '%s': %w", v.Name(), err)
+				return nil, errorsmod.Wrapf(types.ErrPluginVerification, "%s: %v", v.Name(), err)
 			}
 			
 			// 검증 성공 시 파라미터 테이블에서 가중치를 찾아 합산
@@ -150,7 +151,33 @@ func (k msgServer) CreateClaim(goCtx context.Context, msg *types.MsgCreateClaim)
 		ctx.Logger().Info("🚨 [High Priority] Bonus multiplier applied")
 	}
 
-	// Claim 저장
+	claimRegion, err := types.EncodeGeohash(msg.Latitude, msg.Longitude, int(params.RegionGeohashPrecision))
+	if err != nil {
+		claimRegion = ""
+	}
+
+	// 보상 포인트 적립 (실제 토큰은 epoch 종료 시 예산 내에서 비율 분배 후 베스팅)
+	var rewardPoints int64
+	if rewardMultiplier > 0 {
+		rewardPoints = totalScore * rewardMultiplier * params.RewardBaseUnit
+
+		// 엔트로피 기반 적응형 가중치 적용 (소외된 노드/지역 우대)
+		rewardPoints, err = k.ApplyRewardWeights(ctx, msg.NodeId, claimRegion, rewardPoints)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply reward weights: %w", err)
+		}
+		if err := k.TallyReward(ctx, msg.NodeId, claimRegion, msg.Creator, rewardPoints); err != nil {
+			return nil, fmt.Errorf("failed to tally reward points: %w", err)
+		}
+
+		ctx.Logger().Info(fmt.Sprintf("💰 Reward Points Accrued: %s (+%d)", msg.NodeId, rewardPoints))
+	}
+	rewardEpoch, err := k.CurrentEmissionEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Claim 저장 (분쟁 시 보상 회수를 위해 포인트와 epoch 기록)
 	var claim = types.Claim{
 		Latitude:         msg.Latitude,
 		Longitude:        msg.Longitude,
@@ -159,31 +186,40 @@ func (k msgServer) CreateClaim(goCtx context.Context, msg *types.MsgCreateClaim)
 		DataSignature:    msg.DataSignature,
 		TrustScore:       totalScore,
 		RewardMultiplier: rewardMultiplier,
+		Relayer:          msg.Creator,
+		RewardPoints:     rewardPoints,
+		RewardEpoch:      rewardEpoch,
+	}
+	claimID, err := k.AppendClaim(ctx, claim)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store claim: %w", err)
 	}
-	k.AppendClaim(ctx, claim)
 
-	// 보상 지급
+	// 평판 및 리더보드 지역 갱신
 	if rewardMultiplier > 0 {
-		rewardAmount := totalScore * rewardMultiplier * params.RewardBaseUnit
-		
-		if rewardAmount > 0 {
-			rewardCoin := sdk.NewCoins(sdk.NewInt64Coin("stake", rewardAmount))
-
-			if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, rewardCoin); err != nil {
-				return nil, fmt.Errorf("failed to mint coins: %w", err)
-			}
-
-			receiver, err := sdk.AccAddressFromBech32(msg.NodeId)
-			if err != nil {
-				return nil, fmt.Errorf("invalid device address (node_id): %w", err)
-			}
-
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, rewardCoin); err != nil {
-				return nil, fmt.Errorf("failed to send coins: %w", err)
-			}
+		nodeInfo.Reputation += totalScore
+	}
+	if claimRegion != "" {
+		nodeInfo.Region = claimRegion
+	}
+	if err := k.SetNodeInfo(ctx, nodeInfo); err != nil {
+		return nil, fmt.Errorf("failed to update node reputation: %w", err)
+	}
 
-			ctx.Logger().Info(fmt.Sprintf("💰 Reward Sent to Device: %s (Amount: %s)", msg.NodeId, rewardCoin.String()))
-		}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventClaimCreated{
+		ClaimId:          claimID,
+		NodeId:           msg.NodeId,
+		Relayer:          msg.Creator,
+		SensorHash:       msg.SensorHash,
+		Latitude:         msg.Latitude,
+		Longitude:        msg.Longitude,
+		Region:           claimRegion,
+		TrustScore:       totalScore,
+		RewardMultiplier: rewardMultiplier,
+		RewardPoints:     rewardPoints,
+		RewardEpoch:      rewardEpoch,
+	}); err != nil {
+		return nil, err
 	}
 
 	return &types.MsgCreateClaimResponse{}, nil
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	"contactical/x/reality/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (*types.MsgCreatePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	pool, shares, err := k.InitPool(ctx, creator, msg.TokenA, msg.TokenB)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPoolCreated{
+		PoolId:   pool.Id,
+		Creator:  msg.Creator,
+		Reserves: pool.Reserves(),
+		Shares:   shares,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePoolResponse{PoolId: pool.Id, Shares: shares}, nil
+}
+
+func (k msgServer) AddLiquidity(goCtx context.Context, msg *types.MsgAddLiquidity) (*types.MsgAddLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	shares, deposit, err := k.DepositLiquidity(ctx, creator, msg.PoolId, msg.MaxTokens, msg.MinShares)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventLiquidityAdded{
+		PoolId:    msg.PoolId,
+		Creator:   msg.Creator,
+		Deposited: deposit,
+		Shares:    shares,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddLiquidityResponse{Shares: shares, Deposited: deposit}, nil
+}
+
+func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLiquidity) (*types.MsgRemoveLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	withdrawn, err := k.WithdrawLiquidity(ctx, creator, msg.PoolId, msg.Shares, msg.MinTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventLiquidityRemoved{
+		PoolId:    msg.PoolId,
+		Creator:   msg.Creator,
+		Shares:    sdk.NewCoin(types.PoolShareDenom(msg.PoolId), msg.Shares),
+		Withdrawn: withdrawn,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveLiquidityResponse{Withdrawn: withdrawn}, nil
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	"contactical/x/reality/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) PostBond(goCtx context.Context, msg *types.MsgPostBond) (*types.MsgPostBondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bond, err := k.AddBond(ctx, msg.Creator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBondPosted{
+		Owner:  msg.Creator,
+		Amount: msg.Amount,
+		Total:  bond.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPostBondResponse{}, nil
+}
@@ -6,6 +6,7 @@ import (
 
     "contactical/x/reality/types"
 
+    errorsmod "cosmossdk.io/errors"
     sdk "github.com/cosmos/cosmos-sdk/types"
     "google.golang.org/grpc/codes"
     "google.golang.org/grpc/status"
@@ -22,6 +23,14 @@ func (k msgServer) RegisterNode(goCtx context.Context, msg *types.MsgRegisterNod
 		"zk_mode", len(msg.Nullifier) > 0,
 	)
 
+	banned, err := k.BannedNodes.Has(ctx, msg.Creator)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check ban list")
+	}
+	if banned {
+		return nil, errorsmod.Wrap(types.ErrNodeBanned, msg.Creator)
+	}
+
 	nodeInfo := &types.NodeInfo{
 		Creator:      msg.Creator,
 		RegisteredAt: ctx.BlockHeight(),
@@ -36,7 +45,7 @@ func (k msgServer) RegisterNode(goCtx context.Context, msg *types.MsgRegisterNod
 			return nil, status.Error(codes.Internal, "failed to check nullifier")
 		}
 		if has {
-			return nil, status.Error(codes.AlreadyExists, "nullifier already used: node already registered")
+			return nil, errorsmod.Wrap(types.ErrNullifierReused, "node already registered")
 		}
 
 		// 2. Nullifier 저장 (KeySet 사용)
@@ -58,7 +67,7 @@ func (k msgServer) RegisterNode(goCtx context.Context, msg *types.MsgRegisterNod
 		expectedChallenge := msg.Challenge
 		if len(expectedChallenge) == 0 {
 			// ZK 모드도 아니고 TEE 모드도 아니면 에러
-			return nil, status.Error(codes.InvalidArgument, "challenge or nullifier required")
+			return nil, types.ErrMissingProof
 		}
 
 		// TEE 인증서 검증 (개발 모드: 실패해도 막지 않음)
@@ -86,22 +95,52 @@ func (k msgServer) RegisterNode(goCtx context.Context, msg *types.MsgRegisterNod
 		nodeInfo.TrustTier = 1 // 1 = Basic/Legacy
 	}
 
+	// 재등록 시 기존 평판 유지
+	if existing, err := k.NodeInfo.Get(ctx, msg.Creator); err == nil {
+		nodeInfo.Reputation = existing.Reputation
+		nodeInfo.Region = existing.Region
+	}
+
+	// 신뢰 등급별 최소 보증금 확인 (재등록 시 기존 보증금 포함)
+	if !msg.Bond.Amount.IsNil() && msg.Bond.IsPositive() {
+		if _, err := k.AddBond(ctx, msg.Creator, msg.Bond); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to post bond")
+		}
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load params")
+	}
+	bond, err := k.GetBond(ctx, msg.Creator)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load bond")
+	}
+	if minBond, ok := params.MinBond(nodeInfo.TrustTier); ok && bond.Amount.IsLT(minBond) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientBond, "bond %s is below the minimum %s for trust tier %d", bond.Amount, minBond, nodeInfo.TrustTier)
+	}
+
 	// 4. 최종 NodeInfo 저장
-	if err := k.NodeInfo.Set(ctx, msg.Creator, *nodeInfo); err != nil {
-		return nil, status.Errorf(codes.Internal, "노드 정보 저장 실패: %v", err)
+	if err := k.SetNodeInfo(ctx, *nodeInfo); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save node info: %v", err)
+	}
+
+	// 5. 기기 신분증 SBT 발급 (x/nft)
+	if err := k.MintDeviceIdentity(ctx, *nodeInfo); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to mint device identity: %v", err)
 	}
 
 	// [DEBUG LOG]
     fmt.Println("⛓️ [CHAIN] Node Saved to Store!")
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"node_registered",
-			sdk.NewAttribute("creator", msg.Creator),
-			sdk.NewAttribute("trust_tier", fmt.Sprintf("%d", nodeInfo.TrustTier)),
-			sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
-		),
-	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNodeRegistered{
+		NodeId:        msg.Creator,
+		TrustTier:     nodeInfo.TrustTier,
+		SecurityLevel: nodeInfo.SecurityLevel,
+		Bond:          bond.Amount,
+		BlockHeight:   ctx.BlockHeight(),
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to emit event: %v", err)
+	}
 
 	return &types.MsgRegisterNodeResponse{Success: true}, nil
 }
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	"contactical/x/reality/types"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+func (k msgServer) ResolveDispute(ctx context.Context, msg *types.MsgResolveDispute) (*types.MsgResolveDisputeResponse, error) {
+	ok, err := k.IsDisputeResolver(ctx, msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "%s is neither the governance account nor the dispute jury", msg.Authority)
+	}
+
+	if _, err := k.DecideDispute(ctx, msg.DisputeId, msg.Authority, msg.Upheld, msg.Rationale); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResolveDisputeResponse{}, nil
+}
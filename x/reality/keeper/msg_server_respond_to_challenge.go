@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"contactical/x/reality/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RespondToChallenge(goCtx context.Context, msg *types.MsgRespondToChallenge) (*types.MsgRespondToChallengeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	evidence := types.DisputeEvidence{
+		Payload:           msg.Payload,
+		WitnessSignatures: msg.WitnessSignatures,
+		Statement:         msg.Statement,
+	}
+	dispute, err := k.RespondToDispute(ctx, msg.Creator, msg.DisputeId, evidence)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDisputeResponded{
+		DisputeId:          dispute.Id,
+		NodeId:             dispute.Node,
+		Witnesses:          uint32(len(msg.WitnessSignatures)),
+		ResolutionDeadline: dispute.ResolutionDeadline,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRespondToChallengeResponse{}, nil
+}
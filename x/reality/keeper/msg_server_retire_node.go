@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"contactical/x/reality/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RetireNode(goCtx context.Context, msg *types.MsgRetireNode) (*types.MsgRetireNodeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.RemoveNode(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	unbonding, err := k.UnbondAll(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNodeRetired{
+		NodeId:      msg.Creator,
+		Unbonding:   unbonding,
+		BlockHeight: ctx.BlockHeight(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetireNodeResponse{}, nil
+}
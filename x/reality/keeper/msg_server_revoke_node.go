@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"contactical/x/reality/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RevokeNode(goCtx context.Context, msg *types.MsgRevokeNode) (*types.MsgRevokeNodeResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// 차단과 달리 재등록은 허용하되 보증금 일부를 소각
+	if err := k.removeSanctionedNode(ctx, msg.Node); err != nil {
+		return nil, err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slashed, err := k.SlashBond(ctx, msg.Node, params.BondSlashRevocation, "revocation")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := k.UnbondAll(ctx, msg.Node); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNodeRevoked{
+		NodeId:      msg.Node,
+		Reason:      msg.Reason,
+		Slashed:     slashed,
+		BlockHeight: ctx.BlockHeight(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeNodeResponse{}, nil
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"contactical/x/reality/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) Unbond(goCtx context.Context, msg *types.MsgUnbond) (*types.MsgUnbondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// 등록된 노드는 신뢰 등급의 최소 보증금을 남겨야 함 (전액 인출은 은퇴로)
+	bond, err := k.GetBond(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	required, err := k.RequiredBond(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if remaining, err := bond.Amount.SafeSub(msg.Amount); err != nil || remaining.IsLT(required) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientBond, "cannot unbond %s: bond %s must keep at least %s", msg.Amount, bond.Amount, required)
+	}
+
+	entry, err := k.StartUnbonding(ctx, msg.Creator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUnbondingStarted{
+		Owner:          msg.Creator,
+		Amount:         msg.Amount,
+		CompletionTime: entry.CompletionTime,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnbondResponse{CompletionTime: entry.CompletionTime}, nil
+}
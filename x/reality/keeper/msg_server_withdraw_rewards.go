@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"contactical/x/reality/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) WithdrawRewards(goCtx context.Context, msg *types.MsgWithdrawRewards) (*types.MsgWithdrawRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	receiver, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	pending, err := k.WithdrawVestedRewards(ctx, msg.Creator, sdk.AccAddress(receiver))
+	if err != nil {
+		return nil, err
+	}
+	if pending.IsZero() {
+		return nil, errorsmod.Wrap(types.ErrNoVestedRewards, msg.Creator)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRewardPaid{
+		NodeId:   msg.Creator,
+		Receiver: sdk.AccAddress(receiver).String(),
+		Amount:   pending,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawRewardsResponse{Amount: pending}, nil
+}
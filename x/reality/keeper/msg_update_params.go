@@ -5,6 +5,7 @@ import (
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"contactical/x/reality/types"
 )
@@ -24,9 +25,25 @@ func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	// 누적 발행량은 보상 denom 하나로 집계되므로 denom은 변경할 수 없음
+	current, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Params.EpochEmission.Denom != current.EpochEmission.Denom {
+		return nil, errorsmod.Wrapf(types.ErrParamsImmutable, "epoch emission denom is %s, got %s", current.EpochEmission.Denom, req.Params.EpochEmission.Denom)
+	}
+
 	if err := k.Params.Set(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventParamsUpdated{
+		Authority: req.Authority,
+		Params:    req.Params,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -19,6 +19,10 @@ func TestMsgUpdateParams(t *testing.T) {
 	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
 	require.NoError(t, err)
 
+	// the cumulative emission totals are kept in the reward denom
+	otherDenom := types.DefaultParams()
+	otherDenom.EpochEmission.Denom = "gold"
+
 	// default params
 	testCases := []struct {
 		name      string
@@ -36,12 +40,22 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "invalid authority",
 		},
 		{
-			name: "send enabled param",
+			name: "invalid params",
 			input: &types.MsgUpdateParams{
 				Authority: authorityStr,
 				Params:    types.Params{},
 			},
-			expErr: false,
+			expErr:    true,
+			expErrMsg: "reward base unit must be positive",
+		},
+		{
+			name: "emission denom change",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    otherDenom,
+			},
+			expErr:    true,
+			expErrMsg: "epoch emission denom is stake, got gold",
 		},
 		{
 			name: "all good",
@@ -62,6 +76,9 @@ func TestMsgUpdateParams(t *testing.T) {
 				require.Contains(t, err.Error(), tc.expErrMsg)
 			} else {
 				require.NoError(t, err)
+				updated := lastEvent[*types.EventParamsUpdated](t, f.ctx)
+				require.Equal(t, tc.input.Authority, updated.Authority)
+				require.Equal(t, tc.input.Params, updated.Params)
 			}
 		})
 	}
@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"contactical/x/reality/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPoolByDenoms returns the pool trading the two denoms.
+func (k Keeper) GetPoolByDenoms(ctx context.Context, denomA, denomB string) (types.Pool, error) {
+	denomA, denomB = types.SortDenoms(denomA, denomB)
+	id, err := k.PoolByDenoms.Get(ctx, collections.Join(denomA, denomB))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Pool{}, errorsmod.Wrapf(types.ErrPoolNotFound, "%s/%s", denomA, denomB)
+		}
+		return types.Pool{}, err
+	}
+	return k.Pools.Get(ctx, id)
+}
+
+// SetPool stores a pool and its denom pair index.
+func (k Keeper) SetPool(ctx context.Context, pool types.Pool) error {
+	if err := k.Pools.Set(ctx, pool.Id, pool); err != nil {
+		return err
+	}
+	return k.PoolByDenoms.Set(ctx, collections.Join(pool.ReserveA.Denom, pool.ReserveB.Denom), pool.Id)
+}
+
+// InitPool moves the initial reserves from creator into a new pool and
+// mints the initial LP shares to creator.
+func (k Keeper) InitPool(ctx context.Context, creator sdk.AccAddress, tokenA, tokenB sdk.Coin) (types.Pool, sdk.Coin, error) {
+	denomA, denomB := types.SortDenoms(tokenA.Denom, tokenB.Denom)
+	if has, err := k.PoolByDenoms.Has(ctx, collections.Join(denomA, denomB)); err != nil {
+		return types.Pool{}, sdk.Coin{}, err
+	} else if has {
+		return types.Pool{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrPoolExists, "%s/%s", denomA, denomB)
+	}
+
+	id, err := k.PoolSeq.Next(ctx)
+	if err != nil {
+		return types.Pool{}, sdk.Coin{}, err
+	}
+	pool, err := types.NewPool(id, tokenA, tokenB)
+	if err != nil {
+		return types.Pool{}, sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.PoolAccountName, pool.Reserves()); err != nil {
+		return types.Pool{}, sdk.Coin{}, fmt.Errorf("failed to deposit reserves: %w", err)
+	}
+	shares := sdk.NewCoin(pool.ShareDenom, pool.TotalShares)
+	if err := k.mintShares(ctx, creator, shares); err != nil {
+		return types.Pool{}, sdk.Coin{}, err
+	}
+	return pool, shares, k.SetPool(ctx, pool)
+}
+
+// DepositLiquidity deposits at most maxTokens at the pool ratio and mints the
+// matching LP shares to depositor.
+func (k Keeper) DepositLiquidity(ctx context.Context, depositor sdk.AccAddress, poolID uint64, maxTokens sdk.Coins, minShares math.Int) (sdk.Coin, sdk.Coins, error) {
+	pool, err := k.Pools.Get(ctx, poolID)
+	if err != nil {
+		return sdk.Coin{}, nil, errorsmod.Wrapf(types.ErrPoolNotFound, "pool %d", poolID)
+	}
+	shares, deposit, err := pool.DepositShares(maxTokens)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	if !minShares.IsNil() && shares.LT(minShares) {
+		return sdk.Coin{}, nil, errorsmod.Wrapf(types.ErrSlippageExceeded, "shares %s below the minimum %s", shares, minShares)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.PoolAccountName, deposit); err != nil {
+		return sdk.Coin{}, nil, fmt.Errorf("failed to deposit liquidity: %w", err)
+	}
+	minted := sdk.NewCoin(pool.ShareDenom, shares)
+	if err := k.mintShares(ctx, depositor, minted); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	pool.ReserveA = pool.ReserveA.AddAmount(deposit.AmountOf(pool.ReserveA.Denom))
+	pool.ReserveB = pool.ReserveB.AddAmount(deposit.AmountOf(pool.ReserveB.Denom))
+	pool.TotalShares = pool.TotalShares.Add(shares)
+	return minted, deposit, k.SetPool(ctx, pool)
+}
+
+// WithdrawLiquidity burns LP shares of withdrawer and returns the redeemed
+// reserves.
+func (k Keeper) WithdrawLiquidity(ctx context.Context, withdrawer sdk.AccAddress, poolID uint64, shares math.Int, minTokens sdk.Coins) (sdk.Coins, error) {
+	pool, err := k.Pools.Get(ctx, poolID)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrPoolNotFound, "pool %d", poolID)
+	}
+	withdrawn, err := pool.WithdrawTokens(shares)
+	if err != nil {
+		return nil, err
+	}
+	if !withdrawn.IsAllGTE(minTokens) {
+		return nil, errorsmod.Wrapf(types.ErrSlippageExceeded, "withdrawn %s below the minimum %s", withdrawn, minTokens)
+	}
+
+	burned := sdk.NewCoins(sdk.NewCoin(pool.ShareDenom, shares))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, withdrawer, types.PoolAccountName, burned); err != nil {
+		return nil, fmt.Errorf("failed to return pool shares: %w", err)
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.PoolAccountName, burned); err != nil {
+		return nil, fmt.Errorf("failed to burn pool shares: %w", err)
+	}
+	if !withdrawn.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.PoolAccountName, withdrawer, withdrawn); err != nil {
+			return nil, fmt.Errorf("failed to withdraw liquidity: %w", err)
+		}
+	}
+
+	pool.ReserveA = pool.ReserveA.SubAmount(withdrawn.AmountOf(pool.ReserveA.Denom))
+	pool.ReserveB = pool.ReserveB.SubAmount(withdrawn.AmountOf(pool.ReserveB.Denom))
+	pool.TotalShares = pool.TotalShares.Sub(shares)
+	return withdrawn, k.SetPool(ctx, pool)
+}
+
+// routePools loads the pools of a swap route from denomIn to denomOut. An
+// empty route uses the direct pool of the two denoms.
+func (k Keeper) routePools(ctx context.Context, denomIn, denomOut string, route []uint64) ([]types.Pool, error) {
+	if len(route) == 0 {
+		pool, err := k.GetPoolByDenoms(ctx, denomIn, denomOut)
+		if err != nil {
+			return nil, err
+		}
+		return []types.Pool{pool}, nil
+	}
+
+	// MsgSwap.ValidateBasic 과 같은 제한을 적용해 견적이 실행과 어긋나지 않도록 함
+	if len(route) > types.MaxRouteHops {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRoute, "route has %d hops, more than %d", len(route), types.MaxRouteHops)
+	}
+	pools := make([]types.Pool, 0, len(route))
+	seen := make(map[uint64]bool, len(route))
+	denom := denomIn
+	for _, id := range route {
+		if seen[id] {
+			return nil, errorsmod.Wrapf(types.ErrInvalidRoute, "route repeats pool %d", id)
+		}
+		seen[id] = true
+		pool, err := k.Pools.Get(ctx, id)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrPoolNotFound, "pool %d", id)
+		}
+		switch denom {
+		case pool.ReserveA.Denom:
+			denom = pool.ReserveB.Denom
+		case pool.ReserveB.Denom:
+			denom = pool.ReserveA.Denom
+		default:
+			return nil, errorsmod.Wrapf(types.ErrInvalidRoute, "pool %d does not trade %s", id, denom)
+		}
+		pools = append(pools, pool)
+	}
+	if denom != denomOut {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRoute, "route ends in %s instead of %s", denom, denomOut)
+	}
+	return pools, nil
+}
+
+// QuoteSwap quotes selling tokenIn for denomOut through route without
+// executing the swap. It returns the route pools before the swap and the
+// quoted hops.
+func (k Keeper) QuoteSwap(ctx context.Context, tokenIn sdk.Coin, denomOut string, route []uint64) ([]types.Pool, []types.SwapHop, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	pools, err := k.routePools(ctx, tokenIn.Denom, denomOut, route)
+	if err != nil {
+		return nil, nil, err
+	}
+	hops, err := types.QuoteRoute(pools, tokenIn, params.SwapFee)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pools, hops, nil
+}
+
+// SwapExactIn sells tokenIn for denomOut through route. The hops execute
+// together and only the final output is checked against minAmountOut. The
+// swap fees stay in the pools for the liquidity providers. It returns the
+// output and the ids of the pools swapped through, the direct pool when route
+// is empty.
+func (k Keeper) SwapExactIn(ctx context.Context, trader sdk.AccAddress, tokenIn sdk.Coin, denomOut string, route []uint64, minAmountOut math.Int) (sdk.Coin, []uint64, error) {
+	pools, hops, err := k.QuoteSwap(ctx, tokenIn, denomOut, route)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	tokenOut := hops[len(hops)-1].TokenOut
+	if !minAmountOut.IsNil() && tokenOut.Amount.LT(minAmountOut) {
+		return sdk.Coin{}, nil, errorsmod.Wrapf(types.ErrSlippageExceeded, "amount out %s below the minimum %s", tokenOut, minAmountOut)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, trader, types.PoolAccountName, sdk.NewCoins(tokenIn)); err != nil {
+		return sdk.Coin{}, nil, fmt.Errorf("failed to send coins to pool: %w", err)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.PoolAccountName, trader, sdk.NewCoins(tokenOut)); err != nil {
+		return sdk.Coin{}, nil, fmt.Errorf("failed to send coins to trader: %w", err)
+	}
+	swapped := make([]uint64, len(pools))
+	for i, pool := range pools {
+		pool.ApplySwap(hops[i].TokenIn, hops[i].TokenOut)
+		if err := k.SetPool(ctx, pool); err != nil {
+			return sdk.Coin{}, nil, err
+		}
+		swapped[i] = pool.Id
+	}
+	return tokenOut, swapped, nil
+}
+
+func (k Keeper) mintShares(ctx context.Context, receiver sdk.AccAddress, shares sdk.Coin) error {
+	if err := k.bankKeeper.MintCoins(ctx, types.PoolAccountName, sdk.NewCoins(shares)); err != nil {
+		return fmt.Errorf("failed to mint pool shares: %w", err)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.PoolAccountName, receiver, sdk.NewCoins(shares)); err != nil {
+		return fmt.Errorf("failed to send pool shares: %w", err)
+	}
+	return nil
+}
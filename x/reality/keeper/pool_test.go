@@ -0,0 +1,240 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"contactical/x/reality/keeper"
+	"contactical/x/reality/types"
+)
+
+// fund mints coins to owner.
+func fund(t *testing.T, f *fixture, owner string, coins sdk.Coins) {
+	t.Helper()
+	require.NoError(t, f.bankKeeper.MintCoins(f.ctx, "faucet", coins))
+	ownerAddr := sdk.MustAccAddressFromBech32(owner)
+	require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.ctx, "faucet", ownerAddr, coins))
+}
+
+func TestPoolLiquidityLifecycle(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	alice := sdk.AccAddress([]byte("pool_alice__________")).String()
+	bob := sdk.AccAddress([]byte("pool_bob____________")).String()
+	fund(t, f, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 40_000), sdk.NewInt64Coin("token", 10_000)))
+	fund(t, f, bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 8_000), sdk.NewInt64Coin("token", 5_000)))
+
+	created, err := ms.CreatePool(f.ctx, &types.MsgCreatePool{
+		Creator: alice,
+		TokenA:  sdk.NewInt64Coin("token", 10_000),
+		TokenB:  sdk.NewInt64Coin("stake", 40_000),
+	})
+	require.NoError(t, err)
+	// sqrt(40000 * 10000)
+	require.Equal(t, sdk.NewInt64Coin(types.PoolShareDenom(created.PoolId), 20_000), created.Shares)
+	poolCreated := lastEvent[*types.EventPoolCreated](t, f.ctx)
+	require.Equal(t, created.PoolId, poolCreated.PoolId)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 40_000), sdk.NewInt64Coin("token", 10_000)), poolCreated.Reserves)
+	require.Equal(t, created.Shares, poolCreated.Shares)
+
+	_, err = ms.CreatePool(f.ctx, &types.MsgCreatePool{
+		Creator: bob,
+		TokenA:  sdk.NewInt64Coin("stake", 1),
+		TokenB:  sdk.NewInt64Coin("token", 1),
+	})
+	require.Error(t, err)
+
+	pool, err := f.keeper.GetPoolByDenoms(f.ctx, "token", "stake")
+	require.NoError(t, err)
+	require.Equal(t, "stake", pool.ReserveA.Denom)
+
+	// only the amounts at the 4:1 ratio are taken
+	added, err := ms.AddLiquidity(f.ctx, &types.MsgAddLiquidity{
+		Creator:   bob,
+		PoolId:    created.PoolId,
+		MaxTokens: sdk.NewCoins(sdk.NewInt64Coin("stake", 8_000), sdk.NewInt64Coin("token", 5_000)),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(4_000), added.Shares.Amount.Int64())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 8_000), sdk.NewInt64Coin("token", 2_000)), added.Deposited)
+	require.Equal(t, int64(3_000), f.bankKeeper.balances[bob].AmountOf("token").Int64())
+	liquidityAdded := lastEvent[*types.EventLiquidityAdded](t, f.ctx)
+	require.Equal(t, bob, liquidityAdded.Creator)
+	require.Equal(t, added.Deposited, liquidityAdded.Deposited)
+	require.Equal(t, added.Shares, liquidityAdded.Shares)
+
+	_, err = ms.RemoveLiquidity(f.ctx, &types.MsgRemoveLiquidity{
+		Creator:   bob,
+		PoolId:    created.PoolId,
+		Shares:    math.NewInt(4_000),
+		MinTokens: sdk.NewCoins(sdk.NewInt64Coin("token", 2_001)),
+	})
+	require.ErrorIs(t, err, types.ErrSlippageExceeded)
+
+	removed, err := ms.RemoveLiquidity(f.ctx, &types.MsgRemoveLiquidity{
+		Creator: bob,
+		PoolId:  created.PoolId,
+		Shares:  math.NewInt(4_000),
+	})
+	require.NoError(t, err)
+	require.Equal(t, added.Deposited, removed.Withdrawn)
+	liquidityRemoved := lastEvent[*types.EventLiquidityRemoved](t, f.ctx)
+	require.Equal(t, added.Shares, liquidityRemoved.Shares)
+	require.Equal(t, removed.Withdrawn, liquidityRemoved.Withdrawn)
+	require.True(t, f.bankKeeper.balances[bob].AmountOf(types.PoolShareDenom(created.PoolId)).IsZero())
+
+	genesis, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Len(t, genesis.PoolList, 1)
+	require.Equal(t, uint64(1), genesis.PoolCount)
+	require.NoError(t, genesis.Validate())
+}
+
+func TestSwapWithFeeAndSlippage(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.SwapFee = math.LegacyNewDecWithPrec(1, 2)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	lp := sdk.AccAddress([]byte("swap_lp_____________")).String()
+	trader := sdk.AccAddress([]byte("swap_trader_________")).String()
+	fund(t, f, lp, sdk.NewCoins(sdk.NewInt64Coin("stake", 100_000), sdk.NewInt64Coin("token", 100_000)))
+	fund(t, f, trader, sdk.NewCoins(sdk.NewInt64Coin("stake", 10_000)))
+	_, err := ms.CreatePool(f.ctx, &types.MsgCreatePool{
+		Creator: lp,
+		TokenA:  sdk.NewInt64Coin("stake", 100_000),
+		TokenB:  sdk.NewInt64Coin("token", 100_000),
+	})
+	require.NoError(t, err)
+
+	// fee 100, dy = 100000 * 9900 / 109900 = 9008
+	_, err = ms.Swap(f.ctx, &types.MsgSwap{Creator: trader, AmountIn: "10000stake", TargetDenom: "token", MinAmountOut: math.NewInt(9_009)})
+	require.ErrorIs(t, err, types.ErrSlippageExceeded)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(2_000, 0))
+	_, err = ms.Swap(ctx, &types.MsgSwap{Creator: trader, AmountIn: "10000stake", TargetDenom: "token", Deadline: 1_999})
+	require.ErrorIs(t, err, types.ErrSwapExpired)
+
+	resp, err := ms.Swap(ctx, &types.MsgSwap{Creator: trader, AmountIn: "10000stake", TargetDenom: "token", MinAmountOut: math.NewInt(9_008), Deadline: 2_000})
+	require.NoError(t, err)
+	require.Equal(t, "9008token", resp.AmountOut)
+	swap := lastEvent[*types.EventSwap](t, ctx)
+	require.Equal(t, trader, swap.Creator)
+	require.Equal(t, sdk.NewInt64Coin("stake", 10_000), swap.AmountIn)
+	require.Equal(t, sdk.NewInt64Coin("token", 9_008), swap.AmountOut)
+	// 직접 풀 스왑도 풀 id 를 기록
+	require.Equal(t, []uint64{0}, swap.Route)
+
+	pool, err := f.keeper.GetPoolByDenoms(ctx, "stake", "token")
+	require.NoError(t, err)
+	require.Equal(t, int64(110_000), pool.ReserveA.Amount.Int64())
+	require.Equal(t, int64(90_992), pool.ReserveB.Amount.Int64())
+
+	_, err = ms.Swap(ctx, &types.MsgSwap{Creator: trader, AmountIn: "1stake", TargetDenom: "other"})
+	require.Error(t, err)
+}
+
+func TestMultiHopSwap(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	lp := sdk.AccAddress([]byte("route_lp____________")).String()
+	trader := sdk.AccAddress([]byte("route_trader________")).String()
+	fund(t, f, lp, sdk.NewCoins(sdk.NewInt64Coin("stake", 1_002_000), sdk.NewInt64Coin("mid", 2_000_000), sdk.NewInt64Coin("token", 1_002_000)))
+	fund(t, f, trader, sdk.NewCoins(sdk.NewInt64Coin("stake", 20_000)))
+
+	// 얕은 직접 풀과 깊은 stake -> mid -> token 경로
+	for _, reserves := range [][2]sdk.Coin{
+		{sdk.NewInt64Coin("stake", 2_000), sdk.NewInt64Coin("token", 2_000)},
+		{sdk.NewInt64Coin("stake", 1_000_000), sdk.NewInt64Coin("mid", 1_000_000)},
+		{sdk.NewInt64Coin("mid", 1_000_000), sdk.NewInt64Coin("token", 1_000_000)},
+	} {
+		_, err := ms.CreatePool(f.ctx, &types.MsgCreatePool{Creator: lp, TokenA: reserves[0], TokenB: reserves[1]})
+		require.NoError(t, err)
+	}
+
+	best, err := qs.BestRoute(f.ctx, &types.QueryBestRouteRequest{AmountIn: "10000stake", TargetDenom: "token"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, best.Route)
+	require.Len(t, best.Hops, 2)
+	require.Equal(t, "mid", best.Hops[0].TokenOut.Denom)
+
+	direct, err := qs.BestRoute(f.ctx, &types.QueryBestRouteRequest{AmountIn: "10000stake", TargetDenom: "token", MaxHops: 1})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0}, direct.Route)
+	require.True(t, direct.AmountOut.IsLT(best.AmountOut))
+
+	quote, err := qs.SimulateSwap(f.ctx, &types.QuerySimulateSwapRequest{AmountIn: "10000stake", TargetDenom: "token", Route: best.Route})
+	require.NoError(t, err)
+	require.Equal(t, best.AmountOut, quote.AmountOut)
+	require.Equal(t, best.Hops, quote.Hops)
+	// 두 홉의 수수료를 각 입력 denom 으로 합산
+	require.Equal(t, sdk.NewCoins(best.Hops[0].Fee, best.Hops[1].Fee), quote.Fees)
+	require.Equal(t, "stake", best.Hops[0].Fee.Denom)
+	require.Equal(t, "mid", best.Hops[1].Fee.Denom)
+
+	// 실행에서 거부되는 경로는 견적도 거부
+	_, err = qs.SimulateSwap(f.ctx, &types.QuerySimulateSwapRequest{AmountIn: "10000stake", TargetDenom: "stake", Route: []uint64{1, 1}})
+	require.ErrorIs(t, err, types.ErrInvalidRoute)
+	require.Equal(t, math.LegacyOneDec(), quote.SpotPrice)
+
+	// the minimum applies to the final output only
+	_, err = ms.Swap(f.ctx, &types.MsgSwap{Creator: trader, AmountIn: "10000stake", TargetDenom: "token", Route: best.Route, MinAmountOut: best.AmountOut.Amount.AddRaw(1)})
+	require.ErrorIs(t, err, types.ErrSlippageExceeded)
+	_, err = ms.Swap(f.ctx, &types.MsgSwap{Creator: trader, AmountIn: "10000stake", TargetDenom: "token", Route: []uint64{2, 1}})
+	require.Error(t, err)
+	_, err = ms.Swap(f.ctx, &types.MsgSwap{Creator: trader, AmountIn: "10000stake", TargetDenom: "mid", Route: []uint64{1, 2}})
+	require.Error(t, err)
+
+	resp, err := ms.Swap(f.ctx, &types.MsgSwap{Creator: trader, AmountIn: "10000stake", TargetDenom: "token", Route: best.Route, MinAmountOut: best.AmountOut.Amount})
+	require.NoError(t, err)
+	require.Equal(t, best.AmountOut.String(), resp.AmountOut)
+	require.Equal(t, best.AmountOut.Amount, f.bankKeeper.balances[trader].AmountOf("token"))
+	require.Equal(t, best.Route, lastEvent[*types.EventSwap](t, f.ctx).Route)
+
+	// the intermediate denom moves through the pools but never reaches the trader
+	require.True(t, f.bankKeeper.balances[trader].AmountOf("mid").IsZero())
+	first, err := f.keeper.Pools.Get(f.ctx, 1)
+	require.NoError(t, err)
+	second, err := f.keeper.Pools.Get(f.ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(2_000_000), first.ReserveA.AddAmount(second.ReserveA.Amount).Amount.Int64())
+
+	require.Error(t, (&types.MsgSwap{Creator: trader, AmountIn: "1stake", TargetDenom: "token", Route: []uint64{1, 1}}).ValidateBasic())
+}
+
+func TestBestRouteSkipsSharePools(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	lp := sdk.AccAddress([]byte("share_lp____________")).String()
+	fund(t, f, lp, sdk.NewCoins(sdk.NewInt64Coin("stake", 2_000_000), sdk.NewInt64Coin("token", 1_000_000)))
+	_, err := ms.CreatePool(f.ctx, &types.MsgCreatePool{Creator: lp, TokenA: sdk.NewInt64Coin("stake", 1_000_000), TokenB: sdk.NewInt64Coin("token", 1_000_000)})
+	require.NoError(t, err)
+
+	// LP 지분과 stake 를 짝지은 풀
+	shareDenom := types.PoolShareDenom(0)
+	shares := f.bankKeeper.balances[lp].AmountOf(shareDenom)
+	require.True(t, shares.IsPositive())
+	_, err = ms.CreatePool(f.ctx, &types.MsgCreatePool{Creator: lp, TokenA: sdk.NewCoin(shareDenom, shares), TokenB: sdk.NewInt64Coin("stake", 1_000_000)})
+	require.NoError(t, err)
+
+	_, err = qs.BestRoute(f.ctx, &types.QueryBestRouteRequest{AmountIn: "1000" + shareDenom, TargetDenom: "token"})
+	require.ErrorIs(t, err, types.ErrInvalidRoute)
+	_, err = qs.BestRoute(f.ctx, &types.QueryBestRouteRequest{AmountIn: "1000stake", TargetDenom: shareDenom})
+	require.ErrorIs(t, err, types.ErrInvalidRoute)
+
+	// 명시한 경로로는 여전히 거래 가능
+	quote, err := qs.SimulateSwap(f.ctx, &types.QuerySimulateSwapRequest{AmountIn: "1000" + shareDenom, TargetDenom: "token", Route: []uint64{1, 0}})
+	require.NoError(t, err)
+	require.True(t, quote.AmountOut.IsPositive())
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"contactical/x/reality/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) Bond(ctx context.Context, req *types.QueryBondRequest) (*types.QueryBondResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	bond, err := q.k.GetBond(ctx, req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	unbonding, err := q.k.UnbondingEntries(ctx, req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	minBond, err := q.k.RequiredBond(ctx, req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBondResponse{Bond: bond, Unbonding: unbonding, MinBond: minBond}, nil
+}
@@ -50,3 +50,127 @@ func (q queryServer) GetClaim(ctx context.Context, req *types.QueryGetClaimReque
 
 	return &types.QueryGetClaimResponse{Claim: claim}, nil
 }
+
+func (q queryServer) ClaimsByTimeRange(ctx context.Context, req *types.QueryClaimsByTimeRangeRequest) (*types.QueryClaimsByTimeRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.EndTime != 0 && req.EndTime <= req.StartTime {
+		return nil, status.Error(codes.InvalidArgument, "end_time must be after start_time")
+	}
+
+	claims, pageRes, err := q.k.claimsByTime(ctx, req.StartTime, req.EndTime, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClaimsByTimeRangeResponse{Claim: claims, Pagination: pageRes}, nil
+}
+
+// claimsByTime pages through the claims of the time index whose block time
+// is in [start, end), or from start on when end is zero. It follows the
+// semantics of query.CollectionPaginate, which only supports prefixes: the
+// next key is the encoded index key of the first claim of the next page. A
+// key only narrows the range, so keys outside of it are rejected.
+func (k Keeper) claimsByTime(ctx context.Context, start, end int64, pageReq *query.PageRequest) ([]types.Claim, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit, countTotal = query.DefaultLimit, true
+	}
+	// 키로 이어서 조회할 때는 전체 개수를 세지 않음 (CollectionPaginate와 동일)
+	countTotal = countTotal && len(pageReq.Key) == 0
+
+	keyCodec := k.ClaimTimeIndex.KeyCodec()
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).StartInclusive(collections.Join(start, uint64(0)))
+	if end != 0 {
+		rng = rng.EndExclusive(collections.Join(end, uint64(0)))
+	}
+	if len(pageReq.Key) != 0 {
+		_, key, err := keyCodec.Decode(pageReq.Key)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid pagination key: %s", err)
+		}
+		if key.K1() < start || (end != 0 && key.K1() >= end) {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "pagination key at time %d is outside of the time range", key.K1())
+		}
+		if pageReq.Reverse {
+			rng = rng.EndInclusive(key)
+		} else {
+			rng = rng.StartInclusive(key)
+		}
+	}
+	if pageReq.Reverse {
+		rng = rng.Descending()
+	}
+
+	iter, err := k.ClaimTimeIndex.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	var claims []types.Claim
+	var count uint64
+	pageRes := &query.PageResponse{}
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, nil, status.Error(codes.Internal, err.Error())
+		}
+		count++
+		if count <= pageReq.Offset {
+			continue
+		}
+		if count > pageReq.Offset+limit {
+			if pageRes.NextKey == nil {
+				pageRes.NextKey = make([]byte, keyCodec.Size(key))
+				if _, err := keyCodec.Encode(pageRes.NextKey, key); err != nil {
+					return nil, nil, status.Error(codes.Internal, err.Error())
+				}
+			}
+			if !countTotal {
+				break
+			}
+			continue
+		}
+		claim, err := k.Claim.Get(ctx, key.K2())
+		if err != nil {
+			return nil, nil, status.Error(codes.Internal, err.Error())
+		}
+		claims = append(claims, claim)
+	}
+	if countTotal {
+		pageRes.Total = count
+	}
+	return claims, pageRes, nil
+}
+
+func (q queryServer) ClaimsByNode(ctx context.Context, req *types.QueryClaimsByNodeRequest) (*types.QueryClaimsByNodeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Node == "" {
+		return nil, status.Error(codes.InvalidArgument, "node address cannot be empty")
+	}
+
+	claims, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.ClaimCreatorIndex,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Claim, error) {
+			return q.k.Claim.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Node),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClaimsByNodeResponse{Claim: claims, Pagination: pageRes}, nil
+}
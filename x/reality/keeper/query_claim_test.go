@@ -4,7 +4,9 @@ import (
 	"context"
 	"strconv"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
@@ -118,3 +120,141 @@ func TestClaimQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+// appendClaimsAt appends one claim per block time, alternating between two
+// creators, and returns them in order.
+func appendClaimsAt(t *testing.T, f *fixture, times ...int64) []types.Claim {
+	t.Helper()
+	creators := []string{"node-a", "node-b"}
+	var claims []types.Claim
+	for i, unix := range times {
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(int64(i + 1)).WithBlockTime(time.Unix(unix, 0))
+		id, err := f.keeper.AppendClaim(ctx, types.Claim{Creator: creators[i%2], SensorHash: strconv.Itoa(i)})
+		require.NoError(t, err)
+		claim, err := f.keeper.Claim.Get(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, int64(i+1), claim.BlockHeight)
+		require.Equal(t, unix, claim.BlockTime)
+		claims = append(claims, claim)
+	}
+	return claims
+}
+
+func TestClaimQueryByTimeRange(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	// 같은 시각에 기록된 클레임은 id 순서로 정렬
+	claims := appendClaimsAt(t, f, 100, 200, 200, 300, 400)
+
+	byTime := func(start, end int64, pageReq *query.PageRequest) *types.QueryClaimsByTimeRangeResponse {
+		t.Helper()
+		resp, err := qs.ClaimsByTimeRange(f.ctx, &types.QueryClaimsByTimeRangeRequest{StartTime: start, EndTime: end, Pagination: pageReq})
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("Window", func(t *testing.T) {
+		resp := byTime(200, 400, nil)
+		require.Equal(t, claims[1:4], resp.Claim)
+		require.Equal(t, uint64(3), resp.Pagination.Total)
+		require.Nil(t, resp.Pagination.NextKey)
+
+		require.Equal(t, claims[3:], byTime(250, 0, nil).Claim)
+		require.Empty(t, byTime(500, 0, nil).Claim)
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		var got []types.Claim
+		var next []byte
+		for {
+			resp := byTime(0, 0, &query.PageRequest{Key: next, Limit: 2})
+			require.LessOrEqual(t, len(resp.Claim), 2)
+			got = append(got, resp.Claim...)
+			next = resp.Pagination.NextKey
+			if next == nil {
+				break
+			}
+		}
+		require.Equal(t, claims, got)
+	})
+	t.Run("ByOffset", func(t *testing.T) {
+		resp := byTime(100, 400, &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true})
+		require.Equal(t, claims[1:3], resp.Claim)
+		require.Equal(t, uint64(4), resp.Pagination.Total)
+		require.NotNil(t, resp.Pagination.NextKey)
+	})
+	t.Run("Reverse", func(t *testing.T) {
+		resp := byTime(200, 0, &query.PageRequest{Limit: 3, Reverse: true})
+		require.Equal(t, []types.Claim{claims[4], claims[3], claims[2]}, resp.Claim)
+		resp = byTime(200, 0, &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 3, Reverse: true})
+		require.Equal(t, []types.Claim{claims[1]}, resp.Claim)
+		require.Nil(t, resp.Pagination.NextKey)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.ClaimsByTimeRange(f.ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+		_, err = qs.ClaimsByTimeRange(f.ctx, &types.QueryClaimsByTimeRangeRequest{StartTime: 300, EndTime: 300})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = qs.ClaimsByTimeRange(f.ctx, &types.QueryClaimsByTimeRangeRequest{
+			Pagination: &query.PageRequest{Key: []byte{1}, Offset: 1},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("KeyOutsideRange", func(t *testing.T) {
+		// 다른 범위의 키로 범위 밖의 클레임을 조회할 수 없음
+		before := byTime(0, 0, &query.PageRequest{Limit: 4, Reverse: true}).Pagination.NextKey
+		after := byTime(0, 0, &query.PageRequest{Limit: 4}).Pagination.NextKey
+		for _, tc := range []struct {
+			start, end int64
+			key        []byte
+			reverse    bool
+		}{
+			{start: 200, end: 400, key: before},
+			{start: 200, end: 400, key: before, reverse: true},
+			{start: 200, end: 400, key: after},
+			{start: 200, end: 400, key: after, reverse: true},
+		} {
+			_, err := qs.ClaimsByTimeRange(f.ctx, &types.QueryClaimsByTimeRangeRequest{
+				StartTime:  tc.start,
+				EndTime:    tc.end,
+				Pagination: &query.PageRequest{Key: tc.key, Limit: 2, Reverse: tc.reverse},
+			})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+
+		// 범위 안의 키는 범위의 반대쪽 경계를 유지
+		key := byTime(200, 400, &query.PageRequest{Limit: 1}).Pagination.NextKey
+		resp := byTime(200, 400, &query.PageRequest{Key: key, Limit: 5})
+		require.Equal(t, claims[2:4], resp.Claim)
+		key = byTime(200, 400, &query.PageRequest{Limit: 1, Reverse: true}).Pagination.NextKey
+		resp = byTime(200, 400, &query.PageRequest{Key: key, Limit: 5, Reverse: true})
+		require.Equal(t, []types.Claim{claims[2], claims[1]}, resp.Claim)
+	})
+}
+
+func TestClaimQueryByNode(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	claims := appendClaimsAt(t, f, 100, 200, 300, 400, 500)
+
+	resp, err := qs.ClaimsByNode(f.ctx, &types.QueryClaimsByNodeRequest{Node: "node-a"})
+	require.NoError(t, err)
+	require.Equal(t, []types.Claim{claims[0], claims[2], claims[4]}, resp.Claim)
+	require.Equal(t, uint64(3), resp.Pagination.Total)
+
+	resp, err = qs.ClaimsByNode(f.ctx, &types.QueryClaimsByNodeRequest{Node: "node-b", Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []types.Claim{claims[1]}, resp.Claim)
+	resp, err = qs.ClaimsByNode(f.ctx, &types.QueryClaimsByNodeRequest{
+		Node:       "node-b",
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.Claim{claims[3]}, resp.Claim)
+
+	resp, err = qs.ClaimsByNode(f.ctx, &types.QueryClaimsByNodeRequest{Node: "node-c"})
+	require.NoError(t, err)
+	require.Empty(t, resp.Claim)
+
+	_, err = qs.ClaimsByNode(f.ctx, &types.QueryClaimsByNodeRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"contactical/x/reality/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) Dispute(ctx context.Context, req *types.QueryDisputeRequest) (*types.QueryDisputeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	dispute, err := q.k.Disputes.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryDisputeResponse{Dispute: dispute}, nil
+}
+
+func (q queryServer) ListDispute(ctx context.Context, req *types.QueryListDisputeRequest) (*types.QueryListDisputeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	disputes, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.Disputes,
+		req.Pagination,
+		func(_ uint64, value types.Dispute) (bool, error) {
+			return req.Status == types.DISPUTE_STATUS_UNSPECIFIED || value.Status == req.Status, nil
+		},
+		func(_ uint64, value types.Dispute) (types.Dispute, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListDisputeResponse{Dispute: disputes, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ClaimDisputes(ctx context.Context, req *types.QueryClaimDisputesRequest) (*types.QueryClaimDisputesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	disputes := []types.Dispute{}
+	err := q.k.ClaimDisputes.Walk(ctx, collections.NewPrefixedPairRange[uint64, uint64](req.ClaimId), func(key collections.Pair[uint64, uint64]) (bool, error) {
+		dispute, err := q.k.Disputes.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		disputes = append(disputes, dispute)
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClaimDisputesResponse{Dispute: disputes}, nil
+}
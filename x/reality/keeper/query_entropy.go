@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	"contactical/x/reality/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) Entropy(ctx context.Context, req *types.QueryEntropyRequest) (*types.QueryEntropyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var latest *types.EntropySnapshot
+	iter, err := q.k.EntropyHistory.Iterate(ctx, new(collections.Range[int64]).Descending())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+	if iter.Valid() {
+		snapshot, err := iter.Value()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		latest = &snapshot
+	}
+
+	epochNumber := int64(0)
+	if latest != nil {
+		epochNumber = latest.EpochNumber + 1
+	}
+	current, err := q.k.CurrentEntropy(ctx, epochNumber)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEntropyResponse{Current: current, Latest: latest}, nil
+}
+
+func (q queryServer) EntropyHistory(ctx context.Context, req *types.QueryEntropyHistoryRequest) (*types.QueryEntropyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	snapshots, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.EntropyHistory,
+		req.Pagination,
+		func(_ int64, value types.EntropySnapshot) (types.EntropySnapshot, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEntropyHistoryResponse{Snapshots: snapshots, Pagination: pageRes}, nil
+}
+
+func (q queryServer) RewardWeights(ctx context.Context, req *types.QueryRewardWeightsRequest) (*types.QueryRewardWeightsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Node == "" {
+		return nil, status.Error(codes.InvalidArgument, "node address cannot be empty")
+	}
+
+	node, err := q.k.NodeInfo.Get(ctx, req.Node)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "node not found")
+	}
+	nodeWeight, err := q.k.RewardWeightOf(ctx, types.TallyKindNode, node.Creator)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	regionWeight, err := q.k.RewardWeightOf(ctx, types.TallyKindRegion, node.Region)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRewardWeightsResponse{
+		Region:       node.Region,
+		NodeWeight:   nodeWeight,
+		RegionWeight: regionWeight,
+	}, nil
+}
@@ -24,3 +24,16 @@ func (k queryServer) HasNullifier(goCtx context.Context, req *types.QueryHasNull
 
 	return &types.QueryHasNullifierResponse{HasNullifier: has}, nil
 }
+
+func (k queryServer) IsBanned(goCtx context.Context, req *types.QueryIsBannedRequest) (*types.QueryIsBannedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	banned, err := k.k.BannedNodes.Has(goCtx, req.Node)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to check ban list: %v", err))
+	}
+
+	return &types.QueryIsBannedResponse{Banned: banned}, nil
+}
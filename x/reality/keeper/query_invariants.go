@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	"contactical/x/reality/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) Invariants(ctx context.Context, req *types.QueryInvariantsRequest) (*types.QueryInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	results, err := q.k.CheckInvariants(sdk.UnwrapSDKContext(ctx), req.Route)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	broken := false
+	for _, res := range results {
+		broken = broken || res.Broken
+	}
+
+	return &types.QueryInvariantsResponse{Results: results, Broken: broken}, nil
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+
+	"contactical/x/reality/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// descending flips the pagination order so leaderboards start from the highest
+// reputation unless the caller explicitly asks for the reverse.
+func descending(pageReq *query.PageRequest) *query.PageRequest {
+	if pageReq == nil {
+		return &query.PageRequest{Reverse: true}
+	}
+	req := *pageReq
+	req.Reverse = !req.Reverse
+	return &req
+}
+
+func (q queryServer) TopNodes(ctx context.Context, req *types.QueryTopNodesRequest) (*types.QueryTopNodesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	nodes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.ReputationIndex,
+		descending(req.Pagination),
+		func(key collections.Pair[int64, string], _ collections.NoValue) (types.NodeInfo, error) {
+			return q.k.NodeInfo.Get(ctx, key.K2())
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopNodesResponse{NodeInfo: nodes, Pagination: pageRes}, nil
+}
+
+func (q queryServer) RegionLeaderboard(ctx context.Context, req *types.QueryRegionLeaderboardRequest) (*types.QueryRegionLeaderboardResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateGeohash(req.Geohash); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	precision := int(params.RegionGeohashPrecision)
+	if len(req.Geohash) < precision {
+		return nil, status.Errorf(codes.InvalidArgument, "geohash must have at least %d characters", precision)
+	}
+	region := req.Geohash[:precision]
+
+	nodes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.RegionIndex,
+		descending(req.Pagination),
+		func(key collections.Pair[string, collections.Pair[int64, string]], _ collections.NoValue) (types.NodeInfo, error) {
+			return q.k.NodeInfo.Get(ctx, key.K2().K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, collections.Pair[int64, string]](region),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRegionLeaderboardResponse{Region: region, NodeInfo: nodes, Pagination: pageRes}, nil
+}
+
+func (q queryServer) NodeReputationHistory(ctx context.Context, req *types.QueryNodeReputationHistoryRequest) (*types.QueryNodeReputationHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Node == "" {
+		return nil, status.Error(codes.InvalidArgument, "node address cannot be empty")
+	}
+
+	checkpoints, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.ReputationHistory,
+		req.Pagination,
+		func(_ collections.Pair[string, int64], value types.ReputationCheckpoint) (types.ReputationCheckpoint, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, int64](req.Node),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryNodeReputationHistoryResponse{Checkpoints: checkpoints, Pagination: pageRes}, nil
+}
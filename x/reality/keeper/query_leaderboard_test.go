@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"contactical/x/reality/keeper"
+	"contactical/x/reality/types"
+)
+
+func createLeaderboardNodes(t *testing.T, f *fixture) []types.NodeInfo {
+	t.Helper()
+
+	nodes := []types.NodeInfo{
+		{Creator: "node-a", Reputation: 300, Region: "wydm9"},
+		{Creator: "node-b", Reputation: 100, Region: "wydm9"},
+		{Creator: "node-c", Reputation: 200, Region: "xn774"},
+		{Creator: "node-d", Reputation: 50},
+	}
+	for _, node := range nodes {
+		require.NoError(t, f.keeper.SetNodeInfo(f.ctx, node))
+	}
+	return nodes
+}
+
+func TestTopNodesQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	createLeaderboardNodes(t, f)
+
+	// reputation changes must move the node inside the index
+	node, err := f.keeper.NodeInfo.Get(f.ctx, "node-d")
+	require.NoError(t, err)
+	node.Reputation = 250
+	require.NoError(t, f.keeper.SetNodeInfo(f.ctx, node))
+
+	resp, err := qs.TopNodes(f.ctx, &types.QueryTopNodesRequest{})
+	require.NoError(t, err)
+	var order []string
+	for _, n := range resp.NodeInfo {
+		order = append(order, n.Creator)
+	}
+	require.Equal(t, []string{"node-a", "node-d", "node-c", "node-b"}, order)
+
+	resp, err = qs.TopNodes(f.ctx, &types.QueryTopNodesRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, resp.NodeInfo, 2)
+	require.Equal(t, uint64(4), resp.Pagination.Total)
+	require.Equal(t, "node-a", resp.NodeInfo[0].Creator)
+
+	resp, err = qs.TopNodes(f.ctx, &types.QueryTopNodesRequest{Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, resp.NodeInfo, 2)
+	require.Equal(t, "node-c", resp.NodeInfo[0].Creator)
+}
+
+func TestRegionLeaderboardQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	createLeaderboardNodes(t, f)
+
+	resp, err := qs.RegionLeaderboard(f.ctx, &types.QueryRegionLeaderboardRequest{Geohash: "wydm9xyz"})
+	require.NoError(t, err)
+	require.Equal(t, "wydm9", resp.Region)
+	require.Len(t, resp.NodeInfo, 2)
+	require.Equal(t, "node-a", resp.NodeInfo[0].Creator)
+	require.Equal(t, "node-b", resp.NodeInfo[1].Creator)
+
+	_, err = qs.RegionLeaderboard(f.ctx, &types.QueryRegionLeaderboardRequest{Geohash: "wy"})
+	require.Error(t, err)
+}
+
+func TestNodeReputationHistory(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	createLeaderboardNodes(t, f)
+
+	require.NoError(t, f.keeper.CheckpointReputation(f.ctx, 1))
+	node, err := f.keeper.NodeInfo.Get(f.ctx, "node-b")
+	require.NoError(t, err)
+	node.Reputation = 180
+	require.NoError(t, f.keeper.SetNodeInfo(f.ctx, node))
+	require.NoError(t, f.keeper.CheckpointReputation(f.ctx, 2))
+
+	resp, err := qs.NodeReputationHistory(f.ctx, &types.QueryNodeReputationHistoryRequest{Node: "node-b"})
+	require.NoError(t, err)
+	require.Len(t, resp.Checkpoints, 2)
+	require.Equal(t, int64(100), resp.Checkpoints[0].Reputation)
+	require.Equal(t, int64(180), resp.Checkpoints[1].Reputation)
+	require.Equal(t, int64(2), resp.Checkpoints[1].EpochNumber)
+}
+
+func TestEncodeGeohash(t *testing.T) {
+	// Seoul City Hall (37.566535, 126.977969)
+	hash, err := types.EncodeGeohash(37566535, 126977969, 7)
+	require.NoError(t, err)
+	require.Equal(t, "wydm9qy", hash)
+
+	_, err = types.EncodeGeohash(91_000_000, 0, 5)
+	require.Error(t, err)
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"contactical/x/reality/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) Pool(ctx context.Context, req *types.QueryPoolRequest) (*types.QueryPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pool, err := q.k.Pools.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryPoolResponse{Pool: pool}, nil
+}
+
+func (q queryServer) AllPools(ctx context.Context, req *types.QueryAllPoolsRequest) (*types.QueryAllPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pools, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Pools,
+		req.Pagination,
+		func(_ uint64, value types.Pool) (types.Pool, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPoolsResponse{Pool: pools, Pagination: pageRes}, nil
+}
+
+func (q queryServer) SimulateSwap(ctx context.Context, req *types.QuerySimulateSwapRequest) (*types.QuerySimulateSwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	amountIn, err := sdk.ParseCoinNormalized(req.AmountIn)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// MsgSwap 과 같은 경로로 계산해야 견적과 실행 결과가 일치
+	pools, hops, err := q.k.QuoteSwap(ctx, amountIn, req.TargetDenom, req.Route)
+	if err != nil {
+		return nil, err
+	}
+	spot, impact, err := types.RoutePrice(pools, hops)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// 각 홉의 수수료는 그 홉의 입력 denom 으로 부과되므로 denom 별로 합산
+	fees := sdk.NewCoins()
+	for _, hop := range hops {
+		fees = fees.Add(hop.Fee)
+	}
+
+	return &types.QuerySimulateSwapResponse{
+		PoolId:      hops[0].PoolId,
+		AmountOut:   hops[len(hops)-1].TokenOut,
+		Fees:        fees,
+		PriceImpact: impact,
+		SpotPrice:   spot,
+		Hops:        hops,
+	}, nil
+}
+
+func (q queryServer) SpotPrice(ctx context.Context, req *types.QuerySpotPriceRequest) (*types.QuerySpotPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pool, err := q.k.GetPoolByDenoms(ctx, req.BaseDenom, req.QuoteDenom)
+	if err != nil {
+		return nil, err
+	}
+	spot, err := pool.SpotPrice(req.BaseDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySpotPriceResponse{PoolId: pool.Id, SpotPrice: spot}, nil
+}
+
+func (q queryServer) BestRoute(ctx context.Context, req *types.QueryBestRouteRequest) (*types.QueryBestRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	amountIn, err := sdk.ParseCoinNormalized(req.AmountIn)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	maxHops := types.MaxRouteHops
+	if req.MaxHops > 0 && int(req.MaxHops) < maxHops {
+		maxHops = int(req.MaxHops)
+	}
+
+	hops, err := q.k.FindBestRoute(ctx, amountIn, req.TargetDenom, maxHops)
+	if err != nil {
+		return nil, err
+	}
+	route := make([]uint64, len(hops))
+	for i, hop := range hops {
+		route[i] = hop.PoolId
+	}
+
+	return &types.QueryBestRouteResponse{Route: route, AmountOut: hops[len(hops)-1].TokenOut, Hops: hops}, nil
+}
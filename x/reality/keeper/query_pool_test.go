@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"contactical/x/reality/keeper"
+	"contactical/x/reality/types"
+)
+
+func TestSimulateSwapMatchesExecution(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	lp := sdk.AccAddress([]byte("quote_lp____________")).String()
+	trader := sdk.AccAddress([]byte("quote_trader________")).String()
+	fund(t, f, lp, sdk.NewCoins(sdk.NewInt64Coin("stake", 50_000), sdk.NewInt64Coin("token", 200_000)))
+	fund(t, f, trader, sdk.NewCoins(sdk.NewInt64Coin("stake", 5_000)))
+	created, err := ms.CreatePool(f.ctx, &types.MsgCreatePool{
+		Creator: lp,
+		TokenA:  sdk.NewInt64Coin("stake", 50_000),
+		TokenB:  sdk.NewInt64Coin("token", 200_000),
+	})
+	require.NoError(t, err)
+
+	spot, err := qs.SpotPrice(f.ctx, &types.QuerySpotPriceRequest{BaseDenom: "stake", QuoteDenom: "token"})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(4), spot.SpotPrice)
+	inverse, err := qs.SpotPrice(f.ctx, &types.QuerySpotPriceRequest{BaseDenom: "token", QuoteDenom: "stake"})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(25, 2), inverse.SpotPrice)
+
+	quote, err := qs.SimulateSwap(f.ctx, &types.QuerySimulateSwapRequest{AmountIn: "5000stake", TargetDenom: "token"})
+	require.NoError(t, err)
+	require.Equal(t, created.PoolId, quote.PoolId)
+	// fee ceil(5000 * 0.003) = 15, dy = 200000 * 4985 / 54985 = 18132
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 15)), quote.Fees)
+	require.Equal(t, sdk.NewInt64Coin("token", 18_132), quote.AmountOut)
+	require.Equal(t, math.LegacyNewDec(4), quote.SpotPrice)
+	require.True(t, quote.PriceImpact.GT(math.LegacyNewDecWithPrec(9, 2)))
+	require.True(t, quote.PriceImpact.LT(math.LegacyNewDecWithPrec(10, 2)))
+
+	resp, err := ms.Swap(f.ctx, &types.MsgSwap{Creator: trader, AmountIn: "5000stake", TargetDenom: "token", MinAmountOut: quote.AmountOut.Amount})
+	require.NoError(t, err)
+	require.Equal(t, quote.AmountOut.String(), resp.AmountOut)
+
+	pool, err := qs.Pool(f.ctx, &types.QueryPoolRequest{Id: created.PoolId})
+	require.NoError(t, err)
+	require.Equal(t, int64(55_000), pool.Pool.ReserveA.Amount.Int64())
+	all, err := qs.AllPools(f.ctx, &types.QueryAllPoolsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.Pool{pool.Pool}, all.Pool)
+
+	_, err = qs.Pool(f.ctx, &types.QueryPoolRequest{Id: 7})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = qs.SimulateSwap(f.ctx, &types.QuerySimulateSwapRequest{AmountIn: "5000stake", TargetDenom: "other"})
+	require.ErrorIs(t, err, types.ErrPoolNotFound)
+	_, err = qs.SpotPrice(f.ctx, &types.QuerySpotPriceRequest{BaseDenom: "stake", QuoteDenom: "other"})
+	require.ErrorIs(t, err, types.ErrPoolNotFound)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
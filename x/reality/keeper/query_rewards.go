@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	"contactical/x/reality/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) PendingRewards(ctx context.Context, req *types.QueryPendingRewardsRequest) (*types.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Node == "" {
+		return nil, status.Error(codes.InvalidArgument, "node address cannot be empty")
+	}
+
+	pending, err := q.k.WithdrawableRewards(ctx, req.Node)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	points, err := q.k.EpochPoints(ctx, req.Node)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingRewardsResponse{Pending: pending, EpochPoints: points}, nil
+}
+
+func (q queryServer) EmissionProjection(ctx context.Context, req *types.QueryEmissionProjectionRequest) (*types.QueryEmissionProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	epochs := req.Epochs
+	if epochs == 0 {
+		epochs = 10
+	}
+	if epochs > types.MaxEmissionProjection {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot project more than %d epochs", types.MaxEmissionProjection)
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	state, err := q.k.GetEmissionState(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEmissionProjectionResponse{
+		State:       state,
+		Remaining:   params.RemainingEmission(state.TotalMinted),
+		Projections: params.ProjectEmissions(state, epochs),
+	}, nil
+}
+
+func (q queryServer) VestingBalance(ctx context.Context, req *types.QueryVestingBalanceRequest) (*types.QueryVestingBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Node == "" {
+		return nil, status.Error(codes.InvalidArgument, "node address cannot be empty")
+	}
+
+	tranches, err := q.k.NodeTranches(ctx, req.Node)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	resp := &types.QueryVestingBalanceResponse{
+		Vested:    sdk.NewCoins(),
+		Unvested:  sdk.NewCoins(),
+		Withdrawn: sdk.NewCoins(),
+		Tranches:  tranches,
+	}
+	for _, tranche := range tranches {
+		resp.Vested = resp.Vested.Add(tranche.Withdrawable(now)...)
+		resp.Unvested = resp.Unvested.Add(tranche.Unvested(now)...)
+		resp.Withdrawn = resp.Withdrawn.Add(tranche.Withdrawn...)
+	}
+	return resp, nil
+}
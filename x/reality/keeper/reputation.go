@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"contactical/x/reality/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetNodeInfo stores a node and keeps the reputation indexes in sync.
+// All writes to NodeInfo should go through here so the leaderboards never
+// point at stale reputation values.
+func (k Keeper) SetNodeInfo(ctx context.Context, node types.NodeInfo) error {
+	old, err := k.NodeInfo.Get(ctx, node.Creator)
+	switch {
+	case err == nil:
+		if err := k.removeNodeIndexes(ctx, old); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.NodeInfo.Set(ctx, node.Creator, node); err != nil {
+		return err
+	}
+	if err := k.ReputationIndex.Set(ctx, collections.Join(node.Reputation, node.Creator)); err != nil {
+		return err
+	}
+	if node.Region != "" {
+		if err := k.RegionIndex.Set(ctx, collections.Join(node.Region, collections.Join(node.Reputation, node.Creator))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) removeNodeIndexes(ctx context.Context, node types.NodeInfo) error {
+	if err := k.ReputationIndex.Remove(ctx, collections.Join(node.Reputation, node.Creator)); err != nil {
+		return err
+	}
+	if node.Region != "" {
+		return k.RegionIndex.Remove(ctx, collections.Join(node.Region, collections.Join(node.Reputation, node.Creator)))
+	}
+	return nil
+}
+
+// RegionOf returns the leaderboard region for the given claim coordinates.
+func (k Keeper) RegionOf(ctx context.Context, latitude, longitude int64) (string, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	return types.EncodeGeohash(latitude, longitude, int(params.RegionGeohashPrecision))
+}
+
+// CheckpointReputation records the current reputation of every node for the
+// given epoch.
+func (k Keeper) CheckpointReputation(ctx context.Context, epochNumber int64) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	return k.NodeInfo.Walk(ctx, nil, func(creator string, node types.NodeInfo) (bool, error) {
+		checkpoint := types.ReputationCheckpoint{
+			Node:        creator,
+			EpochNumber: epochNumber,
+			Reputation:  node.Reputation,
+			BlockHeight: height,
+		}
+		if err := k.ReputationHistory.Set(ctx, collections.Join(creator, epochNumber), checkpoint); err != nil {
+			return true, err
+		}
+		return false, nil
+	})
+}
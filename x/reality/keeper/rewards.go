@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"contactical/x/reality/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochPoints returns the claim points a node accrued in the epoch in progress.
+// Points are the weighted node tallies also used for the entropy metric.
+func (k Keeper) EpochPoints(ctx context.Context, node string) (int64, error) {
+	points, err := k.RewardTally.Get(ctx, collections.Join(types.TallyKindNode, node))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	return points, nil
+}
+
+// CurrentEmissionEpoch returns the schedule index of the epoch in progress.
+func (k Keeper) CurrentEmissionEpoch(ctx context.Context) (uint64, error) {
+	state, err := k.GetEmissionState(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return state.EpochsElapsed, nil
+}
+
+// GetEmissionState returns the emission schedule progress.
+func (k Keeper) GetEmissionState(ctx context.Context) (types.EmissionState, error) {
+	state, err := k.EmissionState.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.DefaultEmissionState(), nil
+		}
+		return types.EmissionState{}, err
+	}
+	return state.Normalize(), nil
+}
+
+// DistributeEpochRewards mints the scheduled epoch emission into the module
+// escrow as vesting tranches, pro-rata to the claim points nodes accrued
+// during the epoch. The budget halves every HalvingInterval epochs and never
+// exceeds MaxTotalEmission. Rounding dust and budgets of epochs without
+// points are never minted.
+func (k Keeper) DistributeEpochRewards(ctx context.Context, epochNumber int64) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	state, err := k.GetEmissionState(ctx)
+	if err != nil {
+		return err
+	}
+	budget := params.EpochBudget(state)
+	epochIndex := state.EpochsElapsed
+	state.EpochsElapsed++
+
+	distributed, err := k.distribute(ctx, epochIndex, epochNumber, sdk.NewCoin(params.EpochEmission.Denom, budget), params.VestingPeriod)
+	if err != nil {
+		return err
+	}
+	state.TotalMinted = state.TotalMinted.Add(distributed)
+	return k.EmissionState.Set(ctx, state)
+}
+
+// distribute mints the given budget into vesting tranches held by the rewards
+// account, returning the amount minted.
+func (k Keeper) distribute(ctx context.Context, epochIndex uint64, epochNumber int64, emission sdk.Coin, vestingPeriod uint64) (math.Int, error) {
+	var (
+		nodes  []string
+		points []int64
+		total  = math.ZeroInt()
+	)
+	err := k.RewardTally.Walk(ctx, collections.NewPrefixedPairRange[string, string](types.TallyKindNode), func(key collections.Pair[string, string], amount int64) (bool, error) {
+		// 밴 된 노드의 포인트는 분배에서 제외
+		banned, err := k.BannedNodes.Has(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		if banned {
+			return false, nil
+		}
+		nodes = append(nodes, key.K2())
+		points = append(points, amount)
+		total = total.AddRaw(amount)
+		return false, nil
+	})
+	if err != nil {
+		return math.Int{}, err
+	}
+	if total.IsZero() || emission.IsZero() {
+		return math.ZeroInt(), nil
+	}
+
+	shares := make([]math.Int, len(nodes))
+	distributed := math.ZeroInt()
+	for i := range nodes {
+		shares[i] = emission.Amount.MulRaw(points[i]).Quo(total)
+		distributed = distributed.Add(shares[i])
+	}
+	if distributed.IsZero() {
+		return distributed, nil
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.RewardsAccountName, sdk.NewCoins(sdk.NewCoin(emission.Denom, distributed))); err != nil {
+		return math.Int{}, fmt.Errorf("failed to mint epoch rewards: %w", err)
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+	for i, node := range nodes {
+		if shares[i].IsZero() {
+			continue
+		}
+		tranche := types.NewVestingTranche(node, epochIndex, epochNumber, points[i],
+			sdk.NewCoins(sdk.NewCoin(emission.Denom, shares[i])), now, vestingPeriod)
+		if err := k.VestingTranches.Set(ctx, collections.Join(node, epochIndex), tranche); err != nil {
+			return math.Int{}, err
+		}
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventEpochRewardsDistributed{
+		EpochNumber: epochNumber,
+		Amount:      sdk.NewCoin(emission.Denom, distributed),
+		TotalPoints: total,
+		Nodes:       uint32(len(nodes)),
+	}); err != nil {
+		return math.Int{}, err
+	}
+	return distributed, nil
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"contactical/x/reality/keeper"
+	"contactical/x/reality/types"
+)
+
+// mockBankKeeper is an in-memory stand-in for the x/bank keeper.
+// Module accounts are keyed by module name.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	supply   sdk.Coins
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: map[string]sdk.Coins{}}
+}
+
+// moduleAddr returns the balance key of a module account.
+func moduleAddr(moduleName string) string {
+	return authtypes.NewModuleAddress(moduleName).String()
+}
+
+func (m *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, m.balances[addr.String()].AmountOf(denom))
+}
+
+func (m *mockBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) MintCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	m.balances[moduleAddr(moduleName)] = m.balances[moduleAddr(moduleName)].Add(amt...)
+	m.supply = m.supply.Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	balance, ok := m.balances[moduleAddr(moduleName)].SafeSub(amt...)
+	if ok {
+		return fmt.Errorf("insufficient funds to burn: %s < %s", m.balances[moduleAddr(moduleName)], amt)
+	}
+	m.balances[moduleAddr(moduleName)] = balance
+	m.supply = m.supply.Sub(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) send(from, to string, amt sdk.Coins) error {
+	balance, ok := m.balances[from].SafeSub(amt...)
+	if ok {
+		return fmt.Errorf("insufficient funds: %s < %s", m.balances[from], amt)
+	}
+	m.balances[from] = balance
+	m.balances[to] = m.balances[to].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(moduleAddr(senderModule), recipientAddr.String(), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.send(senderAddr.String(), moduleAddr(recipientModule), amt)
+}
+
+func (m *mockBankKeeper) SendCoins(_ context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(fromAddr.String(), toAddr.String(), amt)
+}
+
+func TestEpochRewardDistribution(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.VestingPeriod = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	nodeA := sdk.AccAddress([]byte("reward_node_a_______")).String()
+	nodeB := sdk.AccAddress([]byte("reward_node_b_______")).String()
+	require.NoError(t, f.keeper.TallyReward(f.ctx, nodeA, "wydm9", nodeA, 300))
+	require.NoError(t, f.keeper.TallyReward(f.ctx, nodeB, "xn774", nodeB, 100))
+
+	resp, err := qs.PendingRewards(f.ctx, &types.QueryPendingRewardsRequest{Node: nodeA})
+	require.NoError(t, err)
+	require.Equal(t, int64(300), resp.EpochPoints)
+	require.True(t, resp.Pending.IsZero())
+
+	// claims never mint directly
+	require.True(t, f.bankKeeper.supply.IsZero())
+
+	require.NoError(t, f.keeper.DistributeEpochRewards(f.ctx, 1))
+	emission := params.EpochEmission
+	require.Equal(t, sdk.NewCoins(emission), f.bankKeeper.supply)
+	distributed := lastEvent[*types.EventEpochRewardsDistributed](t, f.ctx)
+	require.Equal(t, emission, distributed.Amount)
+	require.Equal(t, int64(400), distributed.TotalPoints.Int64())
+	require.Equal(t, uint32(2), distributed.Nodes)
+
+	resp, err = qs.PendingRewards(f.ctx, &types.QueryPendingRewardsRequest{Node: nodeA})
+	require.NoError(t, err)
+	require.Equal(t, emission.Amount.MulRaw(3).QuoRaw(4), resp.Pending.AmountOf(emission.Denom))
+
+	withdrawn, err := ms.WithdrawRewards(f.ctx, &types.MsgWithdrawRewards{Creator: nodeB})
+	require.NoError(t, err)
+	require.Equal(t, emission.Amount.QuoRaw(4), withdrawn.Amount.AmountOf(emission.Denom))
+	require.Equal(t, withdrawn.Amount, f.bankKeeper.balances[nodeB])
+
+	_, err = ms.WithdrawRewards(f.ctx, &types.MsgWithdrawRewards{Creator: nodeB})
+	require.Error(t, err)
+}
+
+func TestEpochRewardDistributionWithoutPoints(t *testing.T) {
+	f := initFixture(t)
+
+	require.NoError(t, f.keeper.DistributeEpochRewards(f.ctx, 1))
+	require.True(t, f.bankKeeper.supply.IsZero())
+}
+
+func TestEmissionScheduleHalvingAndCap(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.EpochEmission = sdk.NewInt64Coin(types.DefaultRewardDenom, 1000)
+	params.HalvingInterval = 2
+	params.MaxTotalEmission = math.NewInt(3200)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	resp, err := qs.EmissionProjection(f.ctx, &types.QueryEmissionProjectionRequest{Epochs: 6})
+	require.NoError(t, err)
+	var emissions []int64
+	for _, p := range resp.Projections {
+		emissions = append(emissions, p.Emission.Int64())
+	}
+	// 1000, 1000, 500, 500, then capped at 3200 total
+	require.Equal(t, []int64{1000, 1000, 500, 500, 200, 0}, emissions)
+	require.Equal(t, int64(3200), resp.Projections[5].Cumulative.Int64())
+
+	node := sdk.AccAddress([]byte("emission_node_______")).String()
+	for epoch := int64(1); epoch <= 6; epoch++ {
+		require.NoError(t, f.keeper.TallyReward(f.ctx, node, "", "", 10))
+		require.NoError(t, f.keeper.DistributeEpochRewards(f.ctx, epoch))
+		require.NoError(t, f.keeper.RewardTally.Clear(f.ctx, nil))
+	}
+
+	state, err := f.keeper.GetEmissionState(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), state.EpochsElapsed)
+	require.Equal(t, int64(3200), state.TotalMinted.Int64())
+	require.Equal(t, int64(3200), f.bankKeeper.supply.AmountOf(types.DefaultRewardDenom).Int64())
+
+	resp, err = qs.EmissionProjection(f.ctx, &types.QueryEmissionProjectionRequest{})
+	require.NoError(t, err)
+	require.True(t, resp.Remaining.IsZero())
+	require.Len(t, resp.Projections, 10)
+}
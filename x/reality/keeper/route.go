@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	"contactical/x/reality/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FindBestRoute searches the routes of at most maxHops pools that sell
+// tokenIn for denomOut without revisiting a denom, and returns the hops of
+// the one with the largest output. Ties keep the route found first.
+//
+// Pools holding LP shares are left out of the graph, since anyone can pair
+// the share denoms of existing pools to multiply the routes, and the search
+// stops after exploring types.MaxRouteSearch partial routes. Routes through
+// these pools can still be swapped with an explicit route.
+func (k Keeper) FindBestRoute(ctx context.Context, tokenIn sdk.Coin, denomOut string, maxHops int) ([]types.SwapHop, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 풀 그래프: denom -> 그 denom 을 거래하는 풀 (ID 순)
+	graph := make(map[string][]types.Pool)
+	err = k.Pools.Walk(ctx, nil, func(_ uint64, pool types.Pool) (bool, error) {
+		if types.IsPoolShareDenom(pool.ReserveA.Denom) || types.IsPoolShareDenom(pool.ReserveB.Denom) {
+			return false, nil
+		}
+		graph[pool.ReserveA.Denom] = append(graph[pool.ReserveA.Denom], pool)
+		graph[pool.ReserveB.Denom] = append(graph[pool.ReserveB.Denom], pool)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		best    []types.SwapHop
+		bestOut = math.ZeroInt()
+		path    []types.Pool
+		visited = map[string]bool{tokenIn.Denom: true}
+		budget  = types.MaxRouteSearch
+	)
+	var search func(denom string)
+	search = func(denom string) {
+		if len(path) == maxHops {
+			return
+		}
+		for _, pool := range graph[denom] {
+			if budget == 0 {
+				return
+			}
+			budget--
+			next := pool.ReserveA.Denom
+			if next == denom {
+				next = pool.ReserveB.Denom
+			}
+			if visited[next] {
+				continue
+			}
+
+			path = append(path, pool)
+			if next == denomOut {
+				// 출력이 0 이 되는 경로 등 견적이 안 되는 경로는 건너뜀
+				if hops, err := types.QuoteRoute(path, tokenIn, params.SwapFee); err == nil {
+					if out := hops[len(hops)-1].TokenOut.Amount; out.GT(bestOut) {
+						best, bestOut = hops, out
+					}
+				}
+			} else {
+				visited[next] = true
+				search(next)
+				visited[next] = false
+			}
+			path = path[:len(path)-1]
+		}
+	}
+	search(tokenIn.Denom)
+
+	if best == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRoute, "no route sells %s for %s within %d hops", tokenIn, denomOut, maxHops)
+	}
+	return best, nil
+}
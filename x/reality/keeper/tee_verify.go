@@ -14,12 +14,12 @@ func (k Keeper) ParseAndVerifyTEE(certBase64 string) (AttestationResult, error)
 
     certBytes, err := base64.StdEncoding.DecodeString(certBase64)
     if err != nil {
-        return result, fmt.Errorf("base64 디코딩 실패: %v", err)
+        return result, fmt.Errorf("failed to decode base64 certificate: %w", err)
     }
 
     cert, err := x509.ParseCertificate(certBytes)
     if err != nil {
-        return result, fmt.Errorf("인증서 파싱 실패: %v", err)
+        return result, fmt.Errorf("failed to parse certificate: %w", err)
     }
 
     var ext *pkix.Extension
@@ -31,13 +31,13 @@ func (k Keeper) ParseAndVerifyTEE(certBase64 string) (AttestationResult, error)
         }
     }
     if ext == nil {
-        return result, fmt.Errorf("안드로이드 attestation 확장을 찾지 못함")
+        return result, fmt.Errorf("android key attestation extension not found")
     }
 
     // ✅ pkix.Extension 전체가 아니라 Value(der bytes)를 넘긴다
     attr, err := attestation.ParseExtension(ext.Value)
     if err != nil {
-        return result, fmt.Errorf("안드로이드 증명 데이터 파싱 실패: %v", err)
+        return result, fmt.Errorf("failed to parse android key attestation: %w", err)
     }
 
     // ✅ SecurityLevel 상수 이름
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"contactical/x/reality/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NodeTranches returns every escrowed vesting tranche of a node.
+func (k Keeper) NodeTranches(ctx context.Context, node string) ([]types.VestingTranche, error) {
+	var tranches []types.VestingTranche
+	err := k.VestingTranches.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](node), func(_ collections.Pair[string, uint64], tranche types.VestingTranche) (bool, error) {
+		tranches = append(tranches, tranche)
+		return false, nil
+	})
+	return tranches, err
+}
+
+// WithdrawableRewards returns the vested rewards of a node that have not been
+// withdrawn yet.
+func (k Keeper) WithdrawableRewards(ctx context.Context, node string) (sdk.Coins, error) {
+	tranches, err := k.NodeTranches(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	withdrawable := sdk.NewCoins()
+	for _, tranche := range tranches {
+		withdrawable = withdrawable.Add(tranche.Withdrawable(now)...)
+	}
+	return withdrawable, nil
+}
+
+// WithdrawVestedRewards releases every vested reward of a node from the escrow
+// to its account. Fully vested and withdrawn tranches are pruned.
+func (k Keeper) WithdrawVestedRewards(ctx context.Context, node string, receiver sdk.AccAddress) (sdk.Coins, error) {
+	tranches, err := k.NodeTranches(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	withdrawn := sdk.NewCoins()
+	for _, tranche := range tranches {
+		amount := tranche.Withdrawable(now)
+		if amount.IsZero() {
+			continue
+		}
+		tranche.Withdrawn = tranche.Withdrawn.Add(amount...)
+		withdrawn = withdrawn.Add(amount...)
+
+		key := collections.Join(node, tranche.EpochIndex)
+		if tranche.IsDone(now) {
+			err = k.VestingTranches.Remove(ctx, key)
+		} else {
+			err = k.VestingTranches.Set(ctx, key, tranche)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if withdrawn.IsZero() {
+		return withdrawn, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RewardsAccountName, receiver, withdrawn); err != nil {
+		return nil, fmt.Errorf("failed to send rewards: %w", err)
+	}
+	if err := k.addEmissionTotals(ctx, withdrawn, nil); err != nil {
+		return nil, err
+	}
+	return withdrawn, nil
+}
+
+// ClawbackClaim burns the unvested rewards paid for a claim. A claim whose
+// epoch has not been distributed yet simply loses its points.
+func (k Keeper) ClawbackClaim(ctx context.Context, claimID uint64) (sdk.Coins, error) {
+	claim, err := k.Claim.Get(ctx, claimID)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrClaimNotFound, "claim %d", claimID)
+	}
+	if claim.ClawedBack {
+		return nil, errorsmod.Wrapf(types.ErrClaimClawedBack, "claim %d", claimID)
+	}
+	claim.ClawedBack = true
+	if err := k.Claim.Set(ctx, claimID, claim); err != nil {
+		return nil, err
+	}
+	if claim.RewardPoints <= 0 {
+		return sdk.NewCoins(), nil
+	}
+
+	currentEpoch, err := k.CurrentEmissionEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claim.RewardEpoch == currentEpoch {
+		region, _ := k.RegionOf(ctx, claim.Latitude, claim.Longitude)
+		return sdk.NewCoins(), k.UntallyReward(ctx, claim.Creator, region, claim.Relayer, claim.RewardPoints)
+	}
+
+	key := collections.Join(claim.Creator, claim.RewardEpoch)
+	tranche, err := k.VestingTranches.Get(ctx, key)
+	if err != nil {
+		// 이미 전부 베스팅되어 인출된 보상은 회수할 수 없음
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.NewCoins(), nil
+		}
+		return nil, err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	clawback := sdk.NewCoins()
+	if tranche.Points > 0 {
+		points := math.NewInt(min(claim.RewardPoints, tranche.Points))
+		for _, coin := range tranche.Unvested(now) {
+			clawback = clawback.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(points).QuoRaw(tranche.Points)))
+		}
+		tranche.Points -= points.Int64()
+	}
+	tranche.Clawback(now, clawback)
+
+	if tranche.IsDone(now) {
+		err = k.VestingTranches.Remove(ctx, key)
+	} else {
+		err = k.VestingTranches.Set(ctx, key, tranche)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return clawback, k.burnClawback(ctx, clawback)
+}
+
+// ClawbackNode burns every unvested reward of a node. Vested rewards stay
+// withdrawable.
+func (k Keeper) ClawbackNode(ctx context.Context, node string) (sdk.Coins, error) {
+	tranches, err := k.NodeTranches(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	clawback := sdk.NewCoins()
+	for _, tranche := range tranches {
+		unvested := tranche.Unvested(now)
+		tranche.Clawback(now, unvested)
+		tranche.Points = 0
+		clawback = clawback.Add(unvested...)
+
+		key := collections.Join(node, tranche.EpochIndex)
+		if tranche.IsDone(now) {
+			err = k.VestingTranches.Remove(ctx, key)
+		} else {
+			err = k.VestingTranches.Set(ctx, key, tranche)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return clawback, k.burnClawback(ctx, clawback)
+}
+
+func (k Keeper) burnClawback(ctx context.Context, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.RewardsAccountName, amount); err != nil {
+		return fmt.Errorf("failed to burn clawed back rewards: %w", err)
+	}
+	return k.addEmissionTotals(ctx, nil, amount)
+}
+
+// addEmissionTotals records withdrawn and clawed back rewards of the emission denom.
+func (k Keeper) addEmissionTotals(ctx context.Context, withdrawn, clawedBack sdk.Coins) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	state, err := k.GetEmissionState(ctx)
+	if err != nil {
+		return err
+	}
+	denom := params.EpochEmission.Denom
+	state.TotalWithdrawn = state.TotalWithdrawn.Add(withdrawn.AmountOf(denom))
+	state.TotalClawedBack = state.TotalClawedBack.Add(clawedBack.AmountOf(denom))
+	return k.EmissionState.Set(ctx, state)
+}
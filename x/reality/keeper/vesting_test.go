@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"contactical/x/reality/keeper"
+	"contactical/x/reality/types"
+)
+
+func TestRewardVestingAndClawback(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.EpochEmission = sdk.NewInt64Coin(types.DefaultRewardDenom, 1000)
+	params.VestingPeriod = 100
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	start := time.Unix(1_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start)
+
+	node := sdk.AccAddress([]byte("vesting_node________")).String()
+	for _, points := range []int64{600, 400} {
+		_, err := f.keeper.AppendClaim(ctx, types.Claim{Creator: node, RewardPoints: points})
+		require.NoError(t, err)
+		require.NoError(t, f.keeper.TallyReward(ctx, node, "", "", points))
+	}
+	require.NoError(t, f.keeper.DistributeEpochRewards(ctx, 1))
+	require.NoError(t, f.keeper.RewardTally.Clear(ctx, nil))
+
+	// halfway through the vesting period
+	ctx = ctx.WithBlockTime(start.Add(50 * time.Second))
+	balance, err := qs.VestingBalance(ctx, &types.QueryVestingBalanceRequest{Node: node})
+	require.NoError(t, err)
+	require.Equal(t, int64(500), balance.Vested.AmountOf(types.DefaultRewardDenom).Int64())
+	require.Equal(t, int64(500), balance.Unvested.AmountOf(types.DefaultRewardDenom).Int64())
+
+	withdrawn, err := ms.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Creator: node})
+	require.NoError(t, err)
+	require.Equal(t, int64(500), withdrawn.Amount.AmountOf(types.DefaultRewardDenom).Int64())
+	paid := lastEvent[*types.EventRewardPaid](t, ctx)
+	require.Equal(t, node, paid.NodeId)
+	require.Equal(t, withdrawn.Amount, paid.Amount)
+
+	// disputing the 600-point claim burns 60% of the unvested 500
+	clawback, err := f.keeper.ClawbackClaim(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(300), clawback.AmountOf(types.DefaultRewardDenom).Int64())
+	_, err = f.keeper.ClawbackClaim(ctx, 0)
+	require.ErrorIs(t, err, types.ErrClaimClawedBack)
+
+	// the remaining 200 vests over the rest of the period
+	ctx = ctx.WithBlockTime(start.Add(75 * time.Second))
+	balance, err = qs.VestingBalance(ctx, &types.QueryVestingBalanceRequest{Node: node})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), balance.Vested.AmountOf(types.DefaultRewardDenom).Int64())
+	require.Equal(t, int64(100), balance.Unvested.AmountOf(types.DefaultRewardDenom).Int64())
+
+	// a ban burns whatever is still unvested
+	clawback, err = f.keeper.ClawbackNode(ctx, node)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), clawback.AmountOf(types.DefaultRewardDenom).Int64())
+
+	state, err := f.keeper.GetEmissionState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), state.TotalMinted.Int64())
+	require.Equal(t, int64(500), state.TotalWithdrawn.Int64())
+	require.Equal(t, int64(400), state.TotalClawedBack.Int64())
+	require.Equal(t, int64(600), f.bankKeeper.supply.AmountOf(types.DefaultRewardDenom).Int64())
+
+	ctx = ctx.WithBlockTime(start.Add(200 * time.Second))
+	withdrawn, err = ms.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Creator: node})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), withdrawn.Amount.AmountOf(types.DefaultRewardDenom).Int64())
+	tranches, err := f.keeper.NodeTranches(ctx, node)
+	require.NoError(t, err)
+	require.Empty(t, tranches)
+}
+
+func TestClawbackClaimInCurrentEpoch(t *testing.T) {
+	f := initFixture(t)
+
+	node := sdk.AccAddress([]byte("vesting_node________")).String()
+	id, err := f.keeper.AppendClaim(sdk.UnwrapSDKContext(f.ctx), types.Claim{Creator: node, Relayer: node, RewardPoints: 700})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.TallyReward(f.ctx, node, "", node, 1000))
+
+	clawback, err := f.keeper.ClawbackClaim(f.ctx, id)
+	require.NoError(t, err)
+	require.True(t, clawback.IsZero())
+
+	points, err := f.keeper.EpochPoints(f.ctx, node)
+	require.NoError(t, err)
+	require.Equal(t, int64(300), points)
+}
@@ -29,6 +29,146 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
                     Alias:          []string{"show-claim"},
                     PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
                 },
+                {
+                    RpcMethod: "ClaimsByTimeRange",
+                    Use:       "claims-by-time [start-time] [end-time]",
+                    Short:     "List the claims recorded between two unix times, or since start-time",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+                        {ProtoField: "start_time"},
+                        {ProtoField: "end_time", Optional: true},
+                    },
+                },
+                {
+                    RpcMethod:      "ClaimsByNode",
+                    Use:            "claims-by-node [node]",
+                    Short:          "List the claims created by a node",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "node"}},
+                },
+                {
+                    RpcMethod: "TopNodes",
+                    Use:       "top-nodes",
+                    Short:     "List nodes ordered by reputation",
+                },
+                {
+                    RpcMethod:      "RegionLeaderboard",
+                    Use:            "region-leaderboard [geohash]",
+                    Short:          "List nodes of a geohash region ordered by reputation",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "geohash"}},
+                },
+                {
+                    RpcMethod:      "NodeReputationHistory",
+                    Use:            "reputation-history [node]",
+                    Short:          "Shows the per-epoch reputation checkpoints of a node",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "node"}},
+                },
+                {
+                    RpcMethod:      "IsBanned",
+                    Use:            "is-banned [node]",
+                    Short:          "Shows whether a node has been banned",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "node"}},
+                },
+                {
+                    RpcMethod: "Entropy",
+                    Use:       "entropy",
+                    Short:     "Shows the reward entropy of the current epoch and the last snapshot",
+                },
+                {
+                    RpcMethod: "EntropyHistory",
+                    Use:       "entropy-history",
+                    Short:     "Shows the per-epoch reward entropy snapshots",
+                },
+                {
+                    RpcMethod:      "RewardWeights",
+                    Use:            "reward-weights [node]",
+                    Short:          "Shows the adaptive reward weights applied to a node",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "node"}},
+                },
+                {
+                    RpcMethod:      "PendingRewards",
+                    Use:            "pending-rewards [node]",
+                    Short:          "Shows the withdrawable rewards and current epoch points of a node",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "node"}},
+                },
+                {
+                    RpcMethod: "EmissionProjection",
+                    Use:       "emission-projection",
+                    Short:     "Shows the minted reward supply and projects the emission schedule",
+                },
+                {
+                    RpcMethod:      "VestingBalance",
+                    Use:            "vesting-balance [node]",
+                    Short:          "Shows the vested and unvested reward escrow of a node",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "node"}},
+                },
+                {
+                    RpcMethod:      "Dispute",
+                    Use:            "show-dispute [id]",
+                    Short:          "Shows a claim dispute",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+                },
+                {
+                    RpcMethod: "ListDispute",
+                    Use:       "list-dispute",
+                    Short:     "List claim disputes, optionally filtered with --status",
+                },
+                {
+                    RpcMethod:      "ClaimDisputes",
+                    Use:            "claim-disputes [claim-id]",
+                    Short:          "List every dispute opened against a claim",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "claim_id"}},
+                },
+                {
+                    RpcMethod:      "Bond",
+                    Use:            "bond [owner]",
+                    Short:          "Shows the bond, unbonding entries and minimum bond of an address",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+                },
+                {
+                    RpcMethod:      "Pool",
+                    Use:            "show-pool [id]",
+                    Short:          "Shows a liquidity pool",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+                },
+                {
+                    RpcMethod: "AllPools",
+                    Use:       "list-pool",
+                    Short:     "List all liquidity pools",
+                },
+                {
+                    RpcMethod: "SimulateSwap",
+                    Use:       "simulate-swap [amount-in] [target-denom]",
+                    Short:     "Quote the output, fee and price impact of a swap, optionally through --route",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+                        {ProtoField: "amount_in"},
+                        {ProtoField: "target_denom"},
+                    },
+                },
+                {
+                    RpcMethod: "SpotPrice",
+                    Use:       "spot-price [base-denom] [quote-denom]",
+                    Short:     "Shows the price of base-denom in quote-denom",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+                        {ProtoField: "base_denom"},
+                        {ProtoField: "quote_denom"},
+                    },
+                },
+                {
+                    RpcMethod: "BestRoute",
+                    Use:       "best-route [amount-in] [target-denom]",
+                    Short:     "Find the pool route with the largest swap output",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+                        {ProtoField: "amount_in"},
+                        {ProtoField: "target_denom"},
+                    },
+                },
+                {
+                    RpcMethod: "Invariants",
+                    Use:       "check-invariants [route]",
+                    Short:     "Run the module invariants, or only the one of route, against the current state",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+                        {ProtoField: "route", Optional: true},
+                    },
+                },
                 // this line is used by ignite scaffolding # autocli/query
             },
         },
@@ -54,7 +194,88 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
                 {
                     RpcMethod: "Swap",
                     Use:       "swap --amount-in [amount] --target-denom [denom]",
-                    Short:     "Swap tokens using DEX",
+                    Short:     "Swap tokens using DEX, optionally through the pools of --route",
+                },
+                {
+                    RpcMethod: "RetireNode",
+                    Use:       "retire-node",
+                    Short:     "Retire the sender's node and burn its device identity NFT",
+                },
+                {
+                    RpcMethod: "BanNode",
+                    Skip:      true, // skipped because authority gated
+                },
+                {
+                    RpcMethod: "WithdrawRewards",
+                    Use:       "withdraw-rewards",
+                    Short:     "Withdraw the sender's vested epoch rewards",
+                },
+                {
+                    RpcMethod: "ChallengeClaim",
+                    Use:       "challenge-claim [claim-id] [bond] [reason]",
+                    Short:     "Challenge a claim by posting a dispute bond",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+                        {ProtoField: "claim_id"},
+                        {ProtoField: "bond"},
+                        {ProtoField: "reason"},
+                    },
+                },
+                {
+                    RpcMethod: "RespondToChallenge",
+                    Use:       "respond-to-challenge [dispute-id] [payload] [witness-signatures...]",
+                    Short:     "Submit additional evidence for a challenged claim",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+                        {ProtoField: "dispute_id"},
+                        {ProtoField: "payload"},
+                        {ProtoField: "witness_signatures", Varargs: true},
+                    },
+                },
+                {
+                    RpcMethod: "ResolveDispute",
+                    Skip:      true, // skipped because authority gated
+                },
+                {
+                    RpcMethod: "CreatePool",
+                    Use:       "create-pool [token-a] [token-b]",
+                    Short:     "Create a liquidity pool from its initial reserves",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+                        {ProtoField: "token_a"},
+                        {ProtoField: "token_b"},
+                    },
+                },
+                {
+                    RpcMethod: "AddLiquidity",
+                    Use:       "add-liquidity [pool-id] [max-tokens...]",
+                    Short:     "Deposit both pool denoms for LP shares",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+                        {ProtoField: "pool_id"},
+                        {ProtoField: "max_tokens", Varargs: true},
+                    },
+                },
+                {
+                    RpcMethod: "RemoveLiquidity",
+                    Use:       "remove-liquidity [pool-id] [shares]",
+                    Short:     "Redeem LP shares for the pool reserves",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+                        {ProtoField: "pool_id"},
+                        {ProtoField: "shares"},
+                    },
+                },
+                {
+                    RpcMethod: "RevokeNode",
+                    Skip:      true, // skipped because authority gated
+                },
+                {
+                    RpcMethod:      "PostBond",
+                    Use:            "post-bond [amount]",
+                    Short:          "Add to the sender's node or relayer bond",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+                },
+                {
+                    RpcMethod:      "Unbond",
+                    Use:            "unbond [amount]",
+                    Short:          "Start unbonding part of the sender's bond",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
                 },
                 // this line is used by ignite scaffolding # autocli/tx
             },
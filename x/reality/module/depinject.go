@@ -6,9 +6,12 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 
+	"contactical/x/reality/ante"
 	"contactical/x/reality/keeper"
 	"contactical/x/reality/types"
 )
@@ -21,7 +24,7 @@ func (AppModule) IsOnePerModuleType() {}
 func init() {
 	appconfig.Register(
 		&types.Module{},
-		appconfig.Provide(ProvideModule),
+		appconfig.Provide(ProvideModule, ProvideMessageRouter),
 	)
 }
 
@@ -36,6 +39,7 @@ type ModuleInputs struct {
 	AuthKeeper    types.AuthKeeper
 	BankKeeper    types.BankKeeper
 	StakingKeeper types.StakingKeeper
+	NFTKeeper     types.NFTKeeper
 }
 
 type ModuleOutputs struct {
@@ -43,6 +47,7 @@ type ModuleOutputs struct {
 
 	RealityKeeper keeper.Keeper
 	Module        appmodule.AppModule
+	EpochHooks    epochstypes.EpochHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -58,8 +63,20 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority,
 		in.BankKeeper,
 		in.StakingKeeper,
+		in.NFTKeeper,
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{RealityKeeper: k, Module: m}
+	return ModuleOutputs{
+		RealityKeeper: k,
+		Module:        m,
+		EpochHooks:    epochstypes.EpochHooksWrapper{EpochHooks: k.Hooks()},
+	}
+}
+
+// ProvideMessageRouter wraps the message router of the app so that the modules
+// dispatching messages, like x/gov and x/group, cannot transfer device
+// identity tokens. The app binds baseapp.MessageRouter to it.
+func ProvideMessageRouter(router *baseapp.MsgServiceRouter) *ante.SoulboundRouter {
+	return ante.NewSoulboundRouter(router)
 }
@@ -13,18 +13,13 @@ import (
 
 // GenerateGenesisState creates a randomized GenState of the module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	accs := make([]string, len(simState.Accounts))
-	for i, acc := range simState.Accounts {
-		accs[i] = acc.Address.String()
-	}
-	realityGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
-	}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&realityGenesis)
+	realitysimulation.RandomizedGenState(simState)
 }
 
 // RegisterStoreDecoder registers a decoder.
-func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.keeper.Schema)
+}
 
 // WeightedOperations returns the all the gov module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
@@ -32,6 +27,15 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	const (
 		opWeightMsgCreateClaim          = "op_weight_msg_reality"
 		defaultWeightMsgCreateClaim int = 100
+
+		opWeightMsgRegisterNode          = "op_weight_msg_register_node"
+		defaultWeightMsgRegisterNode int = 40
+
+		opWeightMsgCreatePool          = "op_weight_msg_create_pool"
+		defaultWeightMsgCreatePool int = 10
+
+		opWeightMsgSwap          = "op_weight_msg_swap"
+		defaultWeightMsgSwap int = 50
 	)
 
 	var weightMsgCreateClaim int
@@ -45,10 +49,43 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		realitysimulation.SimulateMsgCreateClaim(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 
+	var weightMsgRegisterNode int
+	simState.AppParams.GetOrGenerate(opWeightMsgRegisterNode, &weightMsgRegisterNode, nil,
+		func(_ *rand.Rand) {
+			weightMsgRegisterNode = defaultWeightMsgRegisterNode
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRegisterNode,
+		realitysimulation.SimulateMsgRegisterNode(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgCreatePool int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreatePool, &weightMsgCreatePool, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreatePool = defaultWeightMsgCreatePool
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreatePool,
+		realitysimulation.SimulateMsgCreatePool(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgSwap int
+	simState.AppParams.GetOrGenerate(opWeightMsgSwap, &weightMsgSwap, nil,
+		func(_ *rand.Rand) {
+			weightMsgSwap = defaultWeightMsgSwap
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSwap,
+		realitysimulation.SimulateMsgSwap(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
 	return operations
 }
 
 // ProposalMsgs returns msgs used for governance proposals for simulations.
 func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
-	return []simtypes.WeightedProposalMsg{}
+	return realitysimulation.ProposalMsgs()
 }
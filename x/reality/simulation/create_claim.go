@@ -1,17 +1,35 @@
 package simulation
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"contactical/x/reality/keeper"
 	"contactical/x/reality/types"
 )
 
+// claimSites are the centers claims are scattered around, in micro degrees,
+// so that regions fill unevenly like real deployments.
+var claimSites = [][2]int64{
+	{37_566_500, 126_978_000},  // Seoul
+	{51_507_400, -127_800},     // London
+	{40_712_800, -74_006_000},  // New York
+	{6_524_400, 3_379_200},     // Lagos
+	{-23_550_500, -46_633_300}, // São Paulo
+	{-33_868_800, 151_209_300}, // Sydney
+}
+
+// SimulateMsgCreateClaim submits a claim from a registered node, signed with
+// its device key, at a plausible location and with other nodes nearby.
 func SimulateMsgCreateClaim(
 	ak types.AuthKeeper,
 	bk types.BankKeeper,
@@ -20,13 +38,66 @@ func SimulateMsgCreateClaim(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgCreateClaim{
-			Creator: simAccount.Address.String(),
+		msg := &types.MsgCreateClaim{}
+
+		var nodes []simtypes.Account
+		for _, acc := range accs {
+			if registered, err := k.NodeInfo.Has(ctx, acc.Address.String()); err == nil && registered {
+				nodes = append(nodes, acc)
+			}
 		}
+		if len(nodes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no registered node"), nil, nil
+		}
+		simAccount := nodes[r.Intn(len(nodes))]
+		msg.Creator = simAccount.Address.String()
+		msg.NodeId = msg.Creator
 
-		// TODO: Handle the CreateClaim simulation
+		site := claimSites[r.Intn(len(claimSites))]
+		msg.Latitude = site[0] + int64(simtypes.RandIntBetween(r, -50_000, 50_000))
+		msg.Longitude = site[1] + int64(simtypes.RandIntBetween(r, -50_000, 50_000))
+		msg.Timestamp = ctx.BlockTime().Unix()
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "CreateClaim simulation not implemented"), nil, nil
+		msg.Payload = fmt.Sprintf("sim observation %d at %d,%d", ctx.BlockHeight(), msg.Latitude, msg.Longitude)
+		if r.Intn(10) == 0 {
+			msg.Payload += " #SOS"
+		}
+		signature, err := SignPayload(DeviceKey(simAccount), msg.Payload)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to sign payload"), nil, err
+		}
+		msg.DataSignature = signature
+
+		sensor := make([]byte, 32)
+		r.Read(sensor)
+		sensorHash := sha256.Sum256(sensor)
+		gnssHash := sha256.Sum256(append(sensor, []byte(msg.Payload)...))
+		msg.SensorHash = hex.EncodeToString(sensorHash[:])
+		msg.GnssHash = hex.EncodeToString(gnssHash[:])
+		anchor := make([]byte, 64)
+		r.Read(anchor)
+		msg.AnchorSignature = base64.StdEncoding.EncodeToString(anchor)
+
+		for _, i := range r.Perm(len(nodes))[:min(len(nodes), r.Intn(4))] {
+			if nodes[i].Address.Equals(simAccount.Address) {
+				continue
+			}
+			msg.NearbyNodes = append(msg.NearbyNodes, nodes[i].Address.String())
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
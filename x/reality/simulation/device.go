@@ -0,0 +1,141 @@
+package simulation
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"time"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"contactical/x/reality/types"
+)
+
+// attestationOID is the Android key attestation certificate extension.
+var attestationOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 1, 17}
+
+// DeviceKey derives the P-256 device key of a simulated node from its account
+// key, so claims can be signed in later operations without keeping state.
+func DeviceKey(acc simtypes.Account) *ecdsa.PrivateKey {
+	return deriveKey(acc.PrivKey.Bytes(), "device")
+}
+
+// deriveKey returns a P-256 key whose scalar is derived from seed and label.
+func deriveKey(seed []byte, label string) *ecdsa.PrivateKey {
+	curve := elliptic.P256()
+	digest := sha256.Sum256(append([]byte(label+"/"), seed...))
+
+	// d in [1, N-1]
+	d := new(big.Int).SetBytes(digest[:])
+	d.Mod(d, new(big.Int).Sub(curve.Params().N, big.NewInt(1)))
+	d.Add(d, big.NewInt(1))
+
+	key := &ecdsa.PrivateKey{D: d}
+	key.Curve = curve
+	key.X, key.Y = curve.ScalarBaseMult(d.FillBytes(make([]byte, 32)))
+	return key
+}
+
+// DevicePubKey returns the PEM encoded public key registered for a node.
+func DevicePubKey(key *ecdsa.PrivateKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), nil
+}
+
+// SignPayload signs a claim payload the way the Android app does: an ASN.1
+// ECDSA signature over the SHA-256 digest, base64 encoded. Signing without a
+// random source yields deterministic RFC 6979 signatures, which keeps
+// simulations reproducible.
+func SignPayload(key *ecdsa.PrivateKey, payload string) (string, error) {
+	digest := sha256.Sum256([]byte(payload))
+	sig, err := key.Sign(nil, digest[:], crypto.SHA256)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// AttestationCertChain returns a base64 DER test certificate chain attesting
+// the device key: a leaf carrying a key attestation record for challenge,
+// signed by a throwaway root.
+func AttestationCertChain(r *rand.Rand, device *ecdsa.PrivateKey, challenge []byte, now time.Time) ([]string, error) {
+	rootSeed := make([]byte, 32)
+	r.Read(rootSeed)
+	root := deriveKey(rootSeed, "attestation-root")
+
+	securityLevel := types.SecurityLevel(types.SecurityLevelTEE)
+	if r.Intn(3) == 0 {
+		securityLevel = types.SecurityLevelStrongBox
+	}
+	bootKey := make([]byte, 32)
+	r.Read(bootKey)
+	record := types.AttestationRecord{
+		AttestationVersion:       100 + r.Intn(300),
+		AttestationSecurityLevel: securityLevel,
+		KeymasterVersion:         100 + r.Intn(300),
+		KeymasterSecurityLevel:   securityLevel,
+		AttestationChallenge:     challenge,
+		TeeEnforced: types.AuthorizationList{
+			Purpose:          []int{2, 3},
+			Algorithm:        3,
+			KeySize:          256,
+			EC_Curve:         1,
+			CreationDateTime: now.UnixMilli(),
+			RootOfTrust: types.RootOfTrust{
+				VerifiedBootKey:   bootKey,
+				DeviceLocked:      r.Intn(5) != 0,
+				VerifiedBootState: r.Intn(2),
+			},
+			OSVersion:    120000 + 10000*r.Intn(4),
+			OSPatchLevel: 202301 + r.Intn(24),
+		},
+	}
+	ext, err := asn1.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode attestation record: %w", err)
+	}
+
+	rootTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(r.Int63()),
+		Subject:               pkix.Name{CommonName: "Simulated Attestation Root"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	leafTemplate := &x509.Certificate{
+		SerialNumber:    big.NewInt(r.Int63()),
+		Subject:         pkix.Name{CommonName: "Android Keystore Key"},
+		NotBefore:       now.Add(-time.Hour),
+		NotAfter:        now.Add(365 * 24 * time.Hour),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtraExtensions: []pkix.Extension{{Id: attestationOID, Value: ext}},
+	}
+
+	// nil random source: deterministic ECDSA signatures
+	rootDER, err := x509.CreateCertificate(nil, rootTemplate, rootTemplate, &root.PublicKey, root)
+	if err != nil {
+		return nil, err
+	}
+	leafDER, err := x509.CreateCertificate(nil, leafTemplate, rootTemplate, &device.PublicKey, root)
+	if err != nil {
+		return nil, err
+	}
+	return []string{
+		base64.StdEncoding.EncodeToString(leafDER),
+		base64.StdEncoding.EncodeToString(rootDER),
+	}, nil
+}
@@ -0,0 +1,246 @@
+package simulation
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"contactical/x/reality/types"
+)
+
+// epochIdentifiers are the epochs the reputation checkpoints may follow. The
+// x/epochs simulation names its epochs identifier-N.
+var epochIdentifiers = []string{"day", "identifier-0", "identifier-1"}
+
+// tradeDenoms are seeded to the simulation accounts next to the bond denom,
+// so that pools can be created and swaps run.
+var tradeDenoms = []string{"usdc", "gold"}
+
+// RandomParams returns random module params that pass Params.Validate.
+func RandomParams(r *rand.Rand) types.Params {
+	params := types.DefaultParams()
+
+	params.RewardBaseUnit = int64(simtypes.RandIntBetween(r, 100, 10_000))
+	params.MaxTrustScore = int64(simtypes.RandIntBetween(r, 50, 200))
+	params.MinScoreThreshold = int64(r.Intn(int(params.MaxTrustScore / 2)))
+	params.SecurityWeights = map[string]int32{
+		"strongbox":        int32(r.Intn(60)),
+		"tee":              int32(r.Intn(40)),
+		"boot_lock":        int32(r.Intn(20)),
+		"density_per_node": int32(r.Intn(30)),
+	}
+	params.EpochIdentifier = epochIdentifiers[r.Intn(len(epochIdentifiers))]
+	params.RegionGeohashPrecision = uint32(simtypes.RandIntBetween(r, 3, 7))
+
+	params.EntropyTarget = math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 50, 96)), 2)
+	params.MaxRewardBoost = math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 10, 31)), 1)
+	params.EpochEmission = sdk.NewInt64Coin(types.DefaultRewardDenom, int64(simtypes.RandIntBetween(r, 1_000_000, 200_000_000)))
+	params.HalvingInterval = uint64(simtypes.RandIntBetween(r, 30, 730))
+	params.MaxTotalEmission = math.NewInt(int64(simtypes.RandIntBetween(r, 1_000_000_000, 100_000_000_000)))
+	params.VestingPeriod = uint64(simtypes.RandIntBetween(r, 60*60, 30*24*60*60))
+
+	params.DisputeMinBond = sdk.NewInt64Coin(types.DefaultRewardDenom, int64(simtypes.RandIntBetween(r, 100_000, 5_000_000)))
+	params.DisputeResponseWindow = uint64(simtypes.RandIntBetween(r, 60*60, 3*24*60*60))
+	params.DisputeResolutionWindow = params.DisputeResponseWindow + uint64(simtypes.RandIntBetween(r, 60*60, 7*24*60*60))
+	params.DisputeSlashFraction = randFraction01(r)
+	params.DisputeReputationPenalty = int64(r.Intn(200))
+
+	// 검증이 약한 등급일수록 높은 보증금
+	zkBond := int64(simtypes.RandIntBetween(r, 100_000, 5_000_000))
+	params.TierBonds = []types.TierBond{
+		{TrustTier: 1, MinBond: sdk.NewInt64Coin(types.BondDenom, zkBond*int64(simtypes.RandIntBetween(r, 1, 11)))},
+		{TrustTier: 2, MinBond: sdk.NewInt64Coin(types.BondDenom, zkBond)},
+	}
+	params.UnbondingPeriod = uint64(simtypes.RandIntBetween(r, 60*60, 21*24*60*60))
+	params.BondSlashDispute = randFraction01(r)
+	params.BondSlashRevocation = randFraction01(r)
+	params.BondSlashBan = math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 50, 101)), 2)
+
+	params.SwapFee = math.LegacyNewDecWithPrec(int64(r.Intn(100)), 4)
+	return params
+}
+
+// RandomizedGenState generates a random genesis state of the module:
+// randomized params, nodes registered from the simulation accounts on both
+// the ZK and TEE paths, a few banned accounts, claims of the nodes and pools
+// of the trade denoms. The trade denoms, the pool reserves and the LP shares
+// are added to the bank genesis, which is generated before this module's.
+func RandomizedGenState(simState *module.SimulationState) {
+	r := simState.Rand
+
+	params := RandomParams(r)
+	genesis := types.DefaultGenesis()
+	genesis.Params = params
+
+	var nodes []types.NodeInfo
+	for _, acc := range simState.Accounts {
+		switch roll := r.Intn(10); {
+		case roll < 3:
+			node, err := randomNode(r, acc, params)
+			if err != nil {
+				panic(err)
+			}
+			if node.Nullifier != "" {
+				genesis.NullifierList = append(genesis.NullifierList, node.Nullifier)
+			}
+			nodes = append(nodes, node)
+		case roll == 3 && r.Intn(5) == 0:
+			genesis.BannedNodeList = append(genesis.BannedNodeList, acc.Address.String())
+		}
+	}
+	genesis.NodeList = nodes
+
+	if len(nodes) > 0 {
+		numClaims := r.Intn(4 * len(nodes))
+		for id := 0; id < numClaims; id++ {
+			genesis.ClaimList = append(genesis.ClaimList, randomClaim(r, uint64(id), nodes[r.Intn(len(nodes))], params))
+		}
+		genesis.ClaimCount = uint64(numClaims)
+	}
+
+	if err := randomPools(simState, genesis); err != nil {
+		panic(err)
+	}
+
+	if err := genesis.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid randomized %s genesis: %s", types.ModuleName, err))
+	}
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
+
+// randomPools seeds the trade denoms to the simulation accounts and creates
+// pools of them against the bond denom and each other, provided by random
+// accounts that receive the LP shares.
+func randomPools(simState *module.SimulationState, genesis *types.GenesisState) error {
+	r := simState.Rand
+	bz, ok := simState.GenState[banktypes.ModuleName]
+	if !ok || len(simState.Accounts) == 0 {
+		return nil
+	}
+	var bankGenesis banktypes.GenesisState
+	if err := simState.Cdc.UnmarshalJSON(bz, &bankGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", banktypes.ModuleName, err)
+	}
+
+	balances := make(map[string]sdk.Coins, len(bankGenesis.Balances))
+	for _, balance := range bankGenesis.Balances {
+		balances[balance.Address] = balance.Coins
+	}
+	minted := sdk.NewCoins()
+	credit := func(addr string, coins sdk.Coins) {
+		balances[addr] = balances[addr].Add(coins...)
+		minted = minted.Add(coins...)
+	}
+
+	// 계정의 절반가량이 거래 denom 을 보유
+	for _, acc := range simState.Accounts {
+		for _, denom := range tradeDenoms {
+			if r.Intn(2) == 0 {
+				amount := simState.InitialStake.QuoRaw(int64(simtypes.RandIntBetween(r, 1, 10)))
+				credit(acc.Address.String(), sdk.NewCoins(sdk.NewCoin(denom, amount)))
+			}
+		}
+	}
+
+	reserve := simState.InitialStake.QuoRaw(100)
+	pairs := [][2]string{{types.BondDenom, tradeDenoms[0]}, {types.BondDenom, tradeDenoms[1]}, {tradeDenoms[0], tradeDenoms[1]}}
+	poolAddr := authtypes.NewModuleAddress(types.PoolAccountName).String()
+	for _, pair := range pairs {
+		if r.Intn(4) == 0 || !reserve.IsPositive() {
+			continue
+		}
+		pool, err := types.NewPool(uint64(len(genesis.PoolList)),
+			sdk.NewCoin(pair[0], reserve.MulRaw(int64(simtypes.RandIntBetween(r, 1, 5)))),
+			sdk.NewCoin(pair[1], reserve.MulRaw(int64(simtypes.RandIntBetween(r, 1, 5)))))
+		if err != nil {
+			return err
+		}
+		provider, _ := simtypes.RandomAcc(r, simState.Accounts)
+		credit(poolAddr, pool.Reserves())
+		credit(provider.Address.String(), sdk.NewCoins(sdk.NewCoin(pool.ShareDenom, pool.TotalShares)))
+		genesis.PoolList = append(genesis.PoolList, pool)
+	}
+	genesis.PoolCount = uint64(len(genesis.PoolList))
+
+	bankGenesis.Balances = bankGenesis.Balances[:0]
+	for addr, coins := range balances {
+		bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{Address: addr, Coins: coins})
+	}
+	bankGenesis.Balances = banktypes.SanitizeGenesisBalances(bankGenesis.Balances)
+	bankGenesis.Supply = bankGenesis.Supply.Add(minted...)
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(&bankGenesis)
+	return nil
+}
+
+// randomNode registers acc as a ZK (trust tier 2) or TEE (trust tier 1) node
+// whose device key signs the claims simulated later.
+func randomNode(r *rand.Rand, acc simtypes.Account, params types.Params) (types.NodeInfo, error) {
+	pubKey, err := DevicePubKey(DeviceKey(acc))
+	if err != nil {
+		return types.NodeInfo{}, err
+	}
+	site := claimSites[r.Intn(len(claimSites))]
+	region, err := types.EncodeGeohash(site[0], site[1], int(params.RegionGeohashPrecision))
+	if err != nil {
+		return types.NodeInfo{}, err
+	}
+
+	node := types.NodeInfo{
+		Creator:    acc.Address.String(),
+		PubKey:     pubKey,
+		Reputation: int64(r.Intn(1000)),
+		Region:     region,
+	}
+	if r.Intn(2) == 0 {
+		digest := sha256.Sum256(append([]byte("genesis/"), acc.Address.Bytes()...))
+		node.Nullifier = hex.EncodeToString(digest[:])
+		node.TrustTier = 2
+		return node, nil
+	}
+	node.TrustTier = 1
+	node.SecurityLevel = int32(simtypes.RandIntBetween(r, 1, 3))
+	node.DeviceLocked = r.Intn(5) != 0
+	node.BootState = int32(r.Intn(2))
+	node.AttestationLevel = int32(simtypes.RandIntBetween(r, 100, 400))
+	node.OsVersion = int32(120000 + 10000*r.Intn(4))
+	node.OsPatchLevel = int32(202301 + r.Intn(24))
+	return node, nil
+}
+
+// randomClaim returns a settled claim of node near one of the claim sites.
+func randomClaim(r *rand.Rand, id uint64, node types.NodeInfo, params types.Params) types.Claim {
+	site := claimSites[r.Intn(len(claimSites))]
+	sensor := make([]byte, 32)
+	r.Read(sensor)
+	sensorHash := sha256.Sum256(sensor)
+	gnssHash := sha256.Sum256(append(sensor, byte(id)))
+	signature := make([]byte, 64)
+	r.Read(signature)
+
+	return types.Claim{
+		Id:               id,
+		SensorHash:       hex.EncodeToString(sensorHash[:]),
+		GnssHash:         hex.EncodeToString(gnssHash[:]),
+		Creator:          node.Creator,
+		DataSignature:    base64.StdEncoding.EncodeToString(signature),
+		TrustScore:       int64(r.Intn(int(params.MaxTrustScore) + 1)),
+		RewardMultiplier: int64(r.Intn(3)),
+		Latitude:         site[0] + int64(simtypes.RandIntBetween(r, -50_000, 50_000)),
+		Longitude:        site[1] + int64(simtypes.RandIntBetween(r, -50_000, 50_000)),
+		Relayer:          node.Creator,
+	}
+}
+
+// randFraction01 returns a random decimal in [0, 1] with two decimal places.
+func randFraction01(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(r.Intn(101)), 2)
+}
@@ -0,0 +1,102 @@
+package simulation
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"contactical/x/reality/keeper"
+	"contactical/x/reality/types"
+)
+
+// SimulateMsgRegisterNode registers a random account as a node, either on the
+// ZK path with a fresh nullifier or on the TEE path with a generated
+// attestation certificate chain, posting the minimum bond of its tier.
+func SimulateMsgRegisterNode(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgRegisterNode{
+			Creator: simAccount.Address.String(),
+		}
+
+		if registered, err := k.NodeInfo.Has(ctx, msg.Creator); err != nil || registered {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "account is already a node"), nil, nil
+		}
+		if banned, err := k.BannedNodes.Has(ctx, msg.Creator); err != nil || banned {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "account is banned"), nil, nil
+		}
+
+		device := DeviceKey(simAccount)
+		pubKey, err := DevicePubKey(device)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to encode device key"), nil, err
+		}
+		msg.PubKey = pubKey
+
+		trustTier := int32(1)
+		if r.Intn(2) == 0 {
+			// ZK 경로: 계정마다 고유한 nullifier
+			trustTier = 2
+			seed := make([]byte, 16)
+			r.Read(seed)
+			digest := sha256.Sum256(append(simAccount.Address.Bytes(), seed...))
+			msg.Nullifier = hex.EncodeToString(digest[:])
+			msg.JwtAud = "contactical-sim"
+			if used, err := k.Nullifiers.Has(ctx, msg.Nullifier); err != nil || used {
+				return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "nullifier already used"), nil, nil
+			}
+		} else {
+			// TEE 경로: 테스트 인증서 체인
+			challenge := make([]byte, 32)
+			r.Read(challenge)
+			msg.Challenge = base64.StdEncoding.EncodeToString(challenge)
+			msg.CertChain, err = AttestationCertChain(r, device, challenge, ctx.BlockTime())
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to generate cert chain"), nil, err
+			}
+		}
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to load params"), nil, err
+		}
+		spent := sdk.NewCoins()
+		if minBond, ok := params.MinBond(trustTier); ok && minBond.IsPositive() {
+			spendable := bk.SpendableCoins(ctx, simAccount.Address)
+			if spendable.AmountOf(minBond.Denom).LT(minBond.Amount) {
+				return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), fmt.Sprintf("cannot afford the tier %d bond", trustTier)), nil, nil
+			}
+			msg.Bond = minBond
+			spent = sdk.NewCoins(minBond)
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spent,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
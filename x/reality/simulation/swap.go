@@ -0,0 +1,156 @@
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"contactical/x/reality/keeper"
+	"contactical/x/reality/types"
+)
+
+// SimulateMsgCreatePool creates a pool from two denoms a random account holds
+// that are not traded together yet.
+func SimulateMsgCreatePool(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgCreatePool{
+			Creator: simAccount.Address.String(),
+		}
+
+		var held sdk.Coins
+		for _, coin := range bk.SpendableCoins(ctx, simAccount.Address) {
+			if !strings.HasPrefix(coin.Denom, types.PoolShareDenomPrefix) && coin.Amount.GT(math.NewInt(1000)) {
+				held = append(held, coin)
+			}
+		}
+		if len(held) < 2 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "account holds fewer than two denoms"), nil, nil
+		}
+		perm := r.Perm(len(held))
+		coinA, coinB := held[perm[0]], held[perm[1]]
+		if _, err := k.GetPoolByDenoms(ctx, coinA.Denom, coinB.Denom); err == nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "pool already exists"), nil, nil
+		}
+
+		// 잔액의 최대 10%로 초기 유동성 공급
+		msg.TokenA = sdk.NewCoin(coinA.Denom, randFraction(r, coinA.Amount, 10))
+		msg.TokenB = sdk.NewCoin(coinB.Denom, randFraction(r, coinB.Amount, 10))
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(msg.TokenA, msg.TokenB),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgSwap sells part of a random account's balance into a pool that
+// trades it, sometimes through the best multi-hop route, with a random
+// slippage tolerance below the quoted output.
+func SimulateMsgSwap(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgSwap{
+			Creator: simAccount.Address.String(),
+		}
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		var pools []types.Pool
+		err := k.Pools.Walk(ctx, nil, func(_ uint64, pool types.Pool) (bool, error) {
+			if pool.TotalShares.IsPositive() &&
+				(spendable.AmountOf(pool.ReserveA.Denom).IsPositive() || spendable.AmountOf(pool.ReserveB.Denom).IsPositive()) {
+				pools = append(pools, pool)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to load pools"), nil, err
+		}
+		if len(pools) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no pool trades a held denom"), nil, nil
+		}
+
+		pool := pools[r.Intn(len(pools))]
+		denomIn, denomOut := pool.ReserveA.Denom, pool.ReserveB.Denom
+		if !spendable.AmountOf(denomIn).IsPositive() || (spendable.AmountOf(denomOut).IsPositive() && r.Intn(2) == 0) {
+			denomIn, denomOut = denomOut, denomIn
+		}
+		tokenIn := sdk.NewCoin(denomIn, randFraction(r, spendable.AmountOf(denomIn), 20))
+		msg.AmountIn = tokenIn.String()
+		msg.TargetDenom = denomOut
+
+		if r.Intn(3) == 0 {
+			hops, err := k.FindBestRoute(ctx, tokenIn, denomOut, types.MaxRouteHops)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no route"), nil, nil
+			}
+			for _, hop := range hops {
+				msg.Route = append(msg.Route, hop.PoolId)
+			}
+		}
+		_, hops, err := k.QuoteSwap(ctx, tokenIn, denomOut, msg.Route)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "swap too small"), nil, nil
+		}
+
+		// 견적보다 최대 5% 낮은 최소 수령량
+		amountOut := hops[len(hops)-1].TokenOut.Amount
+		msg.MinAmountOut = amountOut.Sub(randFraction(r, amountOut, 20)).AddRaw(1)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(tokenIn),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randFraction returns a random amount in [1, amount/divisor].
+func randFraction(r *rand.Rand, amount math.Int, divisor int64) math.Int {
+	limit := amount.QuoRaw(divisor)
+	if !limit.IsPositive() {
+		return math.OneInt()
+	}
+	n, err := simtypes.RandPositiveInt(r, limit)
+	if err != nil {
+		return math.OneInt()
+	}
+	return n
+}
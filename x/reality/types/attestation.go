@@ -78,6 +78,7 @@ type AttestationInfo struct {
 	AttestationLevel int
 	OSVersion        int
 	OSPatchLevel     int
+	Challenge        []byte // 인증서에 기록된 챌린지 (오프체인 검증용)
 }
 
 // ---------------------------------------------------------
@@ -148,5 +149,6 @@ func VerifyAttestation(certChain []string, expectedChallenge string) (*Attestati
 		AttestationLevel: attestation.AttestationVersion,
 		OSVersion:        attestation.TeeEnforced.OSVersion,
 		OSPatchLevel:     attestation.TeeEnforced.OSPatchLevel,
+		Challenge:        attestation.AttestationChallenge,
 	}, nil
 }
\ No newline at end of file
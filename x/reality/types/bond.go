@@ -0,0 +1,15 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// BondDenom is the denom node and relayer bonds are posted in.
+const BondDenom = "stake"
+
+// NewBond returns an empty bond of owner.
+func NewBond(owner string) Bond {
+	return Bond{
+		Owner:   owner,
+		Amount:  sdk.NewInt64Coin(BondDenom, 0),
+		Slashed: sdk.NewInt64Coin(BondDenom, 0),
+	}
+}
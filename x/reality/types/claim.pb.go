@@ -36,8 +36,14 @@ type Claim struct {
 	RewardMultiplier int64  `protobuf:"varint,9,opt,name=reward_multiplier,json=rewardMultiplier,proto3" json:"reward_multiplier,omitempty"`
 	// [Gas Optimization] double -> int64
 	// 실제 값 = 저장된 값 / 1,000,000 (예: 37.123456 -> 37123456)
-	Latitude  int64 `protobuf:"varint,10,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude int64 `protobuf:"varint,11,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude     int64  `protobuf:"varint,10,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude    int64  `protobuf:"varint,11,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Relayer      string `protobuf:"bytes,12,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	RewardPoints int64  `protobuf:"varint,13,opt,name=reward_points,json=rewardPoints,proto3" json:"reward_points,omitempty"`
+	RewardEpoch  uint64 `protobuf:"varint,14,opt,name=reward_epoch,json=rewardEpoch,proto3" json:"reward_epoch,omitempty"`
+	ClawedBack   bool   `protobuf:"varint,15,opt,name=clawed_back,json=clawedBack,proto3" json:"clawed_back,omitempty"`
+	BlockHeight  int64  `protobuf:"varint,16,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTime    int64  `protobuf:"varint,17,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
 }
 
 func (m *Claim) Reset()         { *m = Claim{} }
@@ -150,6 +156,48 @@ func (m *Claim) GetLongitude() int64 {
 	return 0
 }
 
+func (m *Claim) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *Claim) GetRewardPoints() int64 {
+	if m != nil {
+		return m.RewardPoints
+	}
+	return 0
+}
+
+func (m *Claim) GetRewardEpoch() uint64 {
+	if m != nil {
+		return m.RewardEpoch
+	}
+	return 0
+}
+
+func (m *Claim) GetClawedBack() bool {
+	if m != nil {
+		return m.ClawedBack
+	}
+	return false
+}
+
+func (m *Claim) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *Claim) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Claim)(nil), "contactical.reality.v1.Claim")
 }
@@ -159,28 +207,35 @@ func init() {
 }
 
 var fileDescriptor_e3af3642db6b9da9 = []byte{
-	// 334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xcd, 0x4e, 0xf2, 0x40,
-	0x14, 0x86, 0x69, 0xf9, 0xed, 0x90, 0x8f, 0x0f, 0x67, 0x61, 0x26, 0x62, 0x2a, 0x21, 0x31, 0xc1,
-	0x98, 0x40, 0x88, 0xf1, 0x06, 0x74, 0xe3, 0x42, 0x37, 0xb0, 0x73, 0xd3, 0x1c, 0xa7, 0x13, 0x3a,
-	0xc9, 0xd0, 0x21, 0x33, 0xa7, 0x28, 0x77, 0xe1, 0x65, 0xb9, 0x64, 0xe9, 0xd2, 0xc0, 0xce, 0xab,
-	0x30, 0x9d, 0x42, 0x61, 0x79, 0x9e, 0xf7, 0xc9, 0x39, 0x27, 0x79, 0xc9, 0x80, 0xeb, 0x14, 0x81,
-	0xa3, 0xe4, 0xa0, 0xc6, 0x46, 0x80, 0x92, 0xb8, 0x1e, 0xaf, 0x26, 0x63, 0xae, 0x40, 0x2e, 0x46,
-	0x4b, 0xa3, 0x51, 0xd3, 0xf3, 0x13, 0x67, 0xb4, 0x77, 0x46, 0xab, 0xc9, 0xe0, 0xd7, 0x27, 0xf5,
-	0xc7, 0xdc, 0xa3, 0x1d, 0xe2, 0xcb, 0x98, 0x79, 0x7d, 0x6f, 0x58, 0x9b, 0xfa, 0x32, 0xa6, 0x57,
-	0xa4, 0x6d, 0x45, 0x6a, 0xb5, 0x89, 0x12, 0xb0, 0x09, 0xf3, 0xfb, 0xde, 0x30, 0x98, 0x92, 0x02,
-	0x3d, 0x81, 0x4d, 0x68, 0x8f, 0x04, 0xf3, 0xd4, 0xda, 0x22, 0xae, 0xba, 0xb8, 0x95, 0x03, 0x17,
-	0xde, 0x90, 0x2e, 0xa4, 0x3c, 0xd1, 0x26, 0xb2, 0x72, 0x9e, 0x02, 0x66, 0x46, 0xb0, 0x9a, 0x73,
-	0xfe, 0x17, 0x7c, 0x76, 0xc0, 0x94, 0x91, 0x26, 0x37, 0x02, 0x50, 0x1b, 0x56, 0x77, 0xc6, 0x61,
-	0xcc, 0x5f, 0x40, 0x93, 0x59, 0x8c, 0x94, 0x58, 0x09, 0xc5, 0x1a, 0xc5, 0x0b, 0x0e, 0x3d, 0xe7,
-	0x84, 0x5e, 0x93, 0x4e, 0x0c, 0x08, 0x27, 0x37, 0x9a, 0xce, 0xf9, 0x97, 0xd3, 0xe3, 0x85, 0x72,
-	0x8f, 0xe5, 0xda, 0x08, 0xd6, 0xea, 0x7b, 0xc3, 0xea, 0x7e, 0xcf, 0x2c, 0x27, 0xf4, 0x96, 0x9c,
-	0x19, 0xf1, 0x0e, 0x26, 0x8e, 0x16, 0x99, 0x42, 0xb9, 0x54, 0x52, 0x18, 0x16, 0x38, 0xad, 0x5b,
-	0x04, 0x2f, 0x25, 0xa7, 0x17, 0xa4, 0xa5, 0x00, 0x25, 0x66, 0xb1, 0x60, 0xc4, 0x39, 0xe5, 0x4c,
-	0x2f, 0x49, 0xa0, 0x74, 0x3a, 0x2f, 0xc2, 0xb6, 0x0b, 0x8f, 0xe0, 0xe1, 0xfe, 0x6b, 0x1b, 0x7a,
-	0x9b, 0x6d, 0xe8, 0xfd, 0x6c, 0x43, 0xef, 0x73, 0x17, 0x56, 0x36, 0xbb, 0xb0, 0xf2, 0xbd, 0x0b,
-	0x2b, 0xaf, 0xbd, 0xd3, 0x0a, 0x3f, 0xca, 0x12, 0x71, 0xbd, 0x14, 0xf6, 0xad, 0xe1, 0x2a, 0xbc,
-	0xfb, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x24, 0x32, 0x6a, 0xe8, 0x01, 0x00, 0x00,
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0x86, 0xb3, 0x69, 0xda, 0x26, 0x93, 0x26, 0x4d, 0x7d, 0x40, 0x16, 0x85, 0x25, 0x14, 0x21,
+	0x05, 0x21, 0x25, 0xaa, 0x10, 0x2f, 0x50, 0x84, 0xd4, 0x03, 0x48, 0x28, 0xe5, 0xc4, 0x65, 0xe5,
+	0x78, 0x47, 0x59, 0x2b, 0xce, 0x7a, 0x65, 0x3b, 0x29, 0x79, 0x0b, 0xde, 0x84, 0xd7, 0xe0, 0xd8,
+	0x23, 0x47, 0x94, 0xbc, 0x08, 0xf2, 0x78, 0x9b, 0xe6, 0x38, 0xdf, 0xff, 0xad, 0x67, 0x46, 0x3b,
+	0x70, 0x25, 0x4d, 0xe9, 0x85, 0xf4, 0x4a, 0x0a, 0x3d, 0xb1, 0x28, 0xb4, 0xf2, 0x9b, 0xc9, 0xfa,
+	0x7a, 0x22, 0xb5, 0x50, 0xcb, 0x71, 0x65, 0x8d, 0x37, 0xec, 0xd9, 0x81, 0x33, 0xae, 0x9d, 0xf1,
+	0xfa, 0xfa, 0xea, 0x77, 0x0b, 0x8e, 0x3f, 0x05, 0x8f, 0xf5, 0xa1, 0xa9, 0x72, 0x9e, 0x0c, 0x93,
+	0x51, 0x6b, 0xda, 0x54, 0x39, 0x7b, 0x05, 0x5d, 0x87, 0xa5, 0x33, 0x36, 0x2b, 0x84, 0x2b, 0x78,
+	0x73, 0x98, 0x8c, 0x3a, 0x53, 0x88, 0xe8, 0x56, 0xb8, 0x82, 0x5d, 0x42, 0x67, 0x5e, 0x3a, 0x17,
+	0xe3, 0x23, 0x8a, 0xdb, 0x01, 0x50, 0xf8, 0x0e, 0x06, 0xa2, 0x94, 0x85, 0xb1, 0x99, 0x53, 0xf3,
+	0x52, 0xf8, 0x95, 0x45, 0xde, 0x22, 0xe7, 0x3c, 0xf2, 0xbb, 0x47, 0xcc, 0x38, 0x9c, 0x4a, 0x8b,
+	0xc2, 0x1b, 0xcb, 0x8f, 0xc9, 0x78, 0x2c, 0xc3, 0x08, 0xde, 0xae, 0x9c, 0xcf, 0x34, 0xae, 0x51,
+	0xf3, 0x93, 0x38, 0x02, 0xa1, 0x2f, 0x81, 0xb0, 0xb7, 0xd0, 0xcf, 0x85, 0x17, 0x07, 0x3d, 0x4e,
+	0xc9, 0xe9, 0x05, 0xfa, 0xd4, 0x61, 0xff, 0x8e, 0x93, 0xc6, 0x22, 0x6f, 0x0f, 0x93, 0xd1, 0x51,
+	0xfd, 0xce, 0x5d, 0x20, 0xec, 0x3d, 0x5c, 0x58, 0xbc, 0x17, 0x36, 0xcf, 0x96, 0x2b, 0xed, 0x55,
+	0xa5, 0x15, 0x5a, 0xde, 0x21, 0x6d, 0x10, 0x83, 0xaf, 0x7b, 0xce, 0x9e, 0x43, 0x5b, 0x0b, 0xaf,
+	0xfc, 0x2a, 0x47, 0x0e, 0xe4, 0xec, 0x6b, 0xf6, 0x02, 0x3a, 0xda, 0x94, 0xf3, 0x18, 0x76, 0x29,
+	0x7c, 0x02, 0x61, 0x53, 0x8b, 0x5a, 0x6c, 0xd0, 0xf2, 0xb3, 0xb8, 0x69, 0x5d, 0xb2, 0x37, 0xd0,
+	0xab, 0x07, 0xa8, 0x8c, 0x2a, 0xbd, 0xe3, 0x3d, 0xfa, 0xf6, 0x2c, 0xc2, 0x6f, 0xc4, 0xd8, 0x6b,
+	0xa8, 0xeb, 0x0c, 0x2b, 0x23, 0x0b, 0xde, 0xa7, 0x7f, 0xd5, 0x8d, 0xec, 0x73, 0x40, 0x61, 0x53,
+	0xa9, 0xc5, 0x3d, 0xe6, 0xd9, 0x4c, 0xc8, 0x05, 0x3f, 0x1f, 0x26, 0xa3, 0xf6, 0x14, 0x22, 0xba,
+	0x11, 0x72, 0x11, 0xde, 0x98, 0x69, 0x23, 0x17, 0x59, 0x81, 0x6a, 0x5e, 0x78, 0x3e, 0xa0, 0x3e,
+	0x5d, 0x62, 0xb7, 0x84, 0xd8, 0x4b, 0x80, 0xa8, 0x78, 0xb5, 0x44, 0x7e, 0x11, 0x97, 0x20, 0xf2,
+	0x5d, 0x2d, 0xf1, 0xe6, 0xe3, 0x9f, 0x6d, 0x9a, 0x3c, 0x6c, 0xd3, 0xe4, 0xdf, 0x36, 0x4d, 0x7e,
+	0xed, 0xd2, 0xc6, 0xc3, 0x2e, 0x6d, 0xfc, 0xdd, 0xa5, 0x8d, 0x1f, 0x97, 0x87, 0x77, 0xf8, 0x73,
+	0x7f, 0x89, 0x7e, 0x53, 0xa1, 0x9b, 0x9d, 0xd0, 0x1d, 0x7e, 0xf8, 0x1f, 0x00, 0x00, 0xff, 0xff,
+	0xc0, 0x02, 0x0b, 0xce, 0xad, 0x02, 0x00, 0x00,
 }
 
 func (m *Claim) Marshal() (dAtA []byte, err error) {
@@ -203,6 +258,47 @@ func (m *Claim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlockTime != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.BlockTime))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ClawedBack {
+		i--
+		if m.ClawedBack {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.RewardEpoch != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.RewardEpoch))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.RewardPoints != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.RewardPoints))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.Longitude != 0 {
 		i = encodeVarintClaim(dAtA, i, uint64(m.Longitude))
 		i--
@@ -329,6 +425,25 @@ func (m *Claim) Size() (n int) {
 	if m.Longitude != 0 {
 		n += 1 + sovClaim(uint64(m.Longitude))
 	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	if m.RewardPoints != 0 {
+		n += 1 + sovClaim(uint64(m.RewardPoints))
+	}
+	if m.RewardEpoch != 0 {
+		n += 1 + sovClaim(uint64(m.RewardEpoch))
+	}
+	if m.ClawedBack {
+		n += 2
+	}
+	if m.BlockHeight != 0 {
+		n += 2 + sovClaim(uint64(m.BlockHeight))
+	}
+	if m.BlockTime != 0 {
+		n += 2 + sovClaim(uint64(m.BlockTime))
+	}
 	return n
 }
 
@@ -654,6 +769,134 @@ func (m *Claim) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoints", wireType)
+			}
+			m.RewardPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardPoints |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEpoch", wireType)
+			}
+			m.RewardEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawedBack = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			m.BlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClaim(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"github.com/cosmos/gogoproto/proto"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -14,5 +16,34 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRetireNode{},
+		&MsgBanNode{},
+		&MsgWithdrawRewards{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgChallengeClaim{},
+		&MsgRespondToChallenge{},
+		&MsgResolveDispute{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRevokeNode{},
+		&MsgPostBond{},
+		&MsgUnbond{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreatePool{},
+		&MsgAddLiquidity{},
+		&MsgRemoveLiquidity{},
+	)
+
+	// device identity metadata is packed into x/nft tokens as Any
+	registrar.RegisterImplementations((*proto.Message)(nil),
+		&DeviceIdentity{},
+	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
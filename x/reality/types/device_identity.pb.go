@@ -0,0 +1,480 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: contactical/reality/v1/device_identity.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DeviceIdentity is the metadata stored in a node's soulbound x/nft token.
+type DeviceIdentity struct {
+	Node               string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	TrustTier          int32  `protobuf:"varint,2,opt,name=trust_tier,json=trustTier,proto3" json:"trust_tier,omitempty"`
+	SecurityLevel      int32  `protobuf:"varint,3,opt,name=security_level,json=securityLevel,proto3" json:"security_level,omitempty"`
+	AttestationSummary string `protobuf:"bytes,4,opt,name=attestation_summary,json=attestationSummary,proto3" json:"attestation_summary,omitempty"`
+	RegistrationHeight int64  `protobuf:"varint,5,opt,name=registration_height,json=registrationHeight,proto3" json:"registration_height,omitempty"`
+}
+
+func (m *DeviceIdentity) Reset()         { *m = DeviceIdentity{} }
+func (m *DeviceIdentity) String() string { return proto.CompactTextString(m) }
+func (*DeviceIdentity) ProtoMessage()    {}
+func (*DeviceIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36dca06dab6c09bf, []int{0}
+}
+func (m *DeviceIdentity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeviceIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeviceIdentity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeviceIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceIdentity.Merge(m, src)
+}
+func (m *DeviceIdentity) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeviceIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceIdentity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceIdentity proto.InternalMessageInfo
+
+func (m *DeviceIdentity) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *DeviceIdentity) GetTrustTier() int32 {
+	if m != nil {
+		return m.TrustTier
+	}
+	return 0
+}
+
+func (m *DeviceIdentity) GetSecurityLevel() int32 {
+	if m != nil {
+		return m.SecurityLevel
+	}
+	return 0
+}
+
+func (m *DeviceIdentity) GetAttestationSummary() string {
+	if m != nil {
+		return m.AttestationSummary
+	}
+	return ""
+}
+
+func (m *DeviceIdentity) GetRegistrationHeight() int64 {
+	if m != nil {
+		return m.RegistrationHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DeviceIdentity)(nil), "contactical.reality.v1.DeviceIdentity")
+}
+
+func init() {
+	proto.RegisterFile("contactical/reality/v1/device_identity.proto", fileDescriptor_36dca06dab6c09bf)
+}
+
+var fileDescriptor_36dca06dab6c09bf = []byte{
+	// 265 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0xbb, 0xb6, 0x15, 0xba, 0x60, 0x0f, 0x2b, 0x48, 0x40, 0x5c, 0x82, 0x20, 0xe4, 0x20,
+	0x09, 0x45, 0x7c, 0x01, 0xf1, 0xa0, 0xe0, 0x29, 0x7a, 0xf2, 0x12, 0xd6, 0xcd, 0xd0, 0x0e, 0xa4,
+	0xd9, 0xb2, 0x3b, 0x09, 0xee, 0x5b, 0xf8, 0x58, 0x1e, 0x3c, 0xf4, 0xe8, 0x51, 0x92, 0x17, 0x91,
+	0x6e, 0xab, 0xe4, 0x36, 0xfc, 0xdf, 0xff, 0x31, 0xf0, 0xf3, 0x6b, 0x6d, 0x6a, 0x52, 0x9a, 0x50,
+	0xab, 0x2a, 0xb3, 0xa0, 0x2a, 0x24, 0x9f, 0xb5, 0x8b, 0xac, 0x84, 0x16, 0x35, 0x14, 0x58, 0x42,
+	0x4d, 0x48, 0x3e, 0xdd, 0x58, 0x43, 0x46, 0x9c, 0x0d, 0xda, 0xe9, 0xa1, 0x9d, 0xb6, 0x8b, 0xcb,
+	0x2f, 0xc6, 0xe7, 0xf7, 0xc1, 0x78, 0x3c, 0x08, 0x42, 0xf0, 0x49, 0x6d, 0x4a, 0x88, 0x58, 0xcc,
+	0x92, 0x59, 0x1e, 0x6e, 0x71, 0xc1, 0x39, 0xd9, 0xc6, 0x51, 0x41, 0x08, 0x36, 0x3a, 0x8a, 0x59,
+	0x32, 0xcd, 0x67, 0x21, 0x79, 0x41, 0xb0, 0xe2, 0x8a, 0xcf, 0x1d, 0xe8, 0xc6, 0x22, 0xf9, 0xa2,
+	0x82, 0x16, 0xaa, 0x68, 0x1c, 0x2a, 0x27, 0x7f, 0xe9, 0xd3, 0x2e, 0x14, 0x19, 0x3f, 0x55, 0x44,
+	0xe0, 0x48, 0x11, 0x9a, 0xba, 0x70, 0xcd, 0x7a, 0xad, 0xac, 0x8f, 0x26, 0xe1, 0x91, 0x18, 0xa0,
+	0xe7, 0x3d, 0xd9, 0x09, 0x16, 0x96, 0xe8, 0xc8, 0xee, 0x8d, 0x15, 0xe0, 0x72, 0x45, 0xd1, 0x34,
+	0x66, 0xc9, 0x38, 0x17, 0x43, 0xf4, 0x10, 0xc8, 0xdd, 0xed, 0x67, 0x27, 0xd9, 0xb6, 0x93, 0xec,
+	0xa7, 0x93, 0xec, 0xa3, 0x97, 0xa3, 0x6d, 0x2f, 0x47, 0xdf, 0xbd, 0x1c, 0xbd, 0x9e, 0x0f, 0xe7,
+	0x7a, 0xff, 0x1f, 0x8c, 0xfc, 0x06, 0xdc, 0xdb, 0x71, 0x18, 0xe9, 0xe6, 0x37, 0x00, 0x00, 0xff,
+	0xff, 0x62, 0x48, 0xc7, 0x35, 0x54, 0x01, 0x00, 0x00,
+}
+
+func (m *DeviceIdentity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceIdentity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeviceIdentity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegistrationHeight != 0 {
+		i = encodeVarintDeviceIdentity(dAtA, i, uint64(m.RegistrationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AttestationSummary) > 0 {
+		i -= len(m.AttestationSummary)
+		copy(dAtA[i:], m.AttestationSummary)
+		i = encodeVarintDeviceIdentity(dAtA, i, uint64(len(m.AttestationSummary)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SecurityLevel != 0 {
+		i = encodeVarintDeviceIdentity(dAtA, i, uint64(m.SecurityLevel))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TrustTier != 0 {
+		i = encodeVarintDeviceIdentity(dAtA, i, uint64(m.TrustTier))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintDeviceIdentity(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDeviceIdentity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDeviceIdentity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DeviceIdentity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovDeviceIdentity(uint64(l))
+	}
+	if m.TrustTier != 0 {
+		n += 1 + sovDeviceIdentity(uint64(m.TrustTier))
+	}
+	if m.SecurityLevel != 0 {
+		n += 1 + sovDeviceIdentity(uint64(m.SecurityLevel))
+	}
+	l = len(m.AttestationSummary)
+	if l > 0 {
+		n += 1 + l + sovDeviceIdentity(uint64(l))
+	}
+	if m.RegistrationHeight != 0 {
+		n += 1 + sovDeviceIdentity(uint64(m.RegistrationHeight))
+	}
+	return n
+}
+
+func sovDeviceIdentity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDeviceIdentity(x uint64) (n int) {
+	return sovDeviceIdentity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DeviceIdentity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeviceIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceIdentity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeviceIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeviceIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustTier", wireType)
+			}
+			m.TrustTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrustTier |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityLevel", wireType)
+			}
+			m.SecurityLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecurityLevel |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationSummary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeviceIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeviceIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationSummary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationHeight", wireType)
+			}
+			m.RegistrationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeviceIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeviceIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDeviceIdentity(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDeviceIdentity
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeviceIdentity
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeviceIdentity
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDeviceIdentity
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDeviceIdentity
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDeviceIdentity
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDeviceIdentity        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDeviceIdentity          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDeviceIdentity = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,14 @@
+package types
+
+// IsActive reports whether the dispute still waits for a response or a resolution.
+func (d Dispute) IsActive() bool {
+	return d.Status == DISPUTE_STATUS_AWAITING_RESPONSE || d.Status == DISPUTE_STATUS_AWAITING_RESOLUTION
+}
+
+// Deadline returns the deadline of the current stage of an active dispute.
+func (d Dispute) Deadline() int64 {
+	if d.Status == DISPUTE_STATUS_AWAITING_RESOLUTION {
+		return d.ResolutionDeadline
+	}
+	return d.ResponseDeadline
+}
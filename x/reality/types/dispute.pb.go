@@ -0,0 +1,1410 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: contactical/reality/v1/dispute.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DisputeStatus is the state of a claim dispute.
+//
+// AWAITING_RESPONSE -> AWAITING_RESOLUTION -> UPHELD | REJECTED | EXPIRED
+// AWAITING_RESPONSE -> UPHELD (the node did not respond in time)
+type DisputeStatus int32
+
+const (
+	DISPUTE_STATUS_UNSPECIFIED DisputeStatus = 0
+	// The node may submit evidence until the response deadline.
+	DISPUTE_STATUS_AWAITING_RESPONSE DisputeStatus = 1
+	// Governance or the dispute jury may resolve until the resolution deadline.
+	DISPUTE_STATUS_AWAITING_RESOLUTION DisputeStatus = 2
+	// The challenge succeeded: the claim was fraudulent.
+	DISPUTE_STATUS_UPHELD DisputeStatus = 3
+	// The challenge failed: the claim stands.
+	DISPUTE_STATUS_REJECTED DisputeStatus = 4
+	// Nobody resolved the dispute in time; the bond was refunded.
+	DISPUTE_STATUS_EXPIRED DisputeStatus = 5
+)
+
+var DisputeStatus_name = map[int32]string{
+	0: "DISPUTE_STATUS_UNSPECIFIED",
+	1: "DISPUTE_STATUS_AWAITING_RESPONSE",
+	2: "DISPUTE_STATUS_AWAITING_RESOLUTION",
+	3: "DISPUTE_STATUS_UPHELD",
+	4: "DISPUTE_STATUS_REJECTED",
+	5: "DISPUTE_STATUS_EXPIRED",
+}
+
+var DisputeStatus_value = map[string]int32{
+	"DISPUTE_STATUS_UNSPECIFIED":         0,
+	"DISPUTE_STATUS_AWAITING_RESPONSE":   1,
+	"DISPUTE_STATUS_AWAITING_RESOLUTION": 2,
+	"DISPUTE_STATUS_UPHELD":              3,
+	"DISPUTE_STATUS_REJECTED":            4,
+	"DISPUTE_STATUS_EXPIRED":             5,
+}
+
+func (x DisputeStatus) String() string {
+	return proto.EnumName(DisputeStatus_name, int32(x))
+}
+
+func (DisputeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_91eca97ba8476592, []int{0}
+}
+
+// DisputeEvidence is the additional evidence submitted by the challenged node.
+type DisputeEvidence struct {
+	// payload is the full signed claim payload.
+	Payload string `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// witness_signatures are signatures of nearby nodes vouching for the claim.
+	WitnessSignatures []string `protobuf:"bytes,2,rep,name=witness_signatures,json=witnessSignatures,proto3" json:"witness_signatures,omitempty"`
+	Statement         string   `protobuf:"bytes,3,opt,name=statement,proto3" json:"statement,omitempty"`
+	SubmittedAt       int64    `protobuf:"varint,4,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+}
+
+func (m *DisputeEvidence) Reset()         { *m = DisputeEvidence{} }
+func (m *DisputeEvidence) String() string { return proto.CompactTextString(m) }
+func (*DisputeEvidence) ProtoMessage()    {}
+func (*DisputeEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91eca97ba8476592, []int{0}
+}
+func (m *DisputeEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisputeEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisputeEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisputeEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisputeEvidence.Merge(m, src)
+}
+func (m *DisputeEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisputeEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisputeEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisputeEvidence proto.InternalMessageInfo
+
+func (m *DisputeEvidence) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func (m *DisputeEvidence) GetWitnessSignatures() []string {
+	if m != nil {
+		return m.WitnessSignatures
+	}
+	return nil
+}
+
+func (m *DisputeEvidence) GetStatement() string {
+	if m != nil {
+		return m.Statement
+	}
+	return ""
+}
+
+func (m *DisputeEvidence) GetSubmittedAt() int64 {
+	if m != nil {
+		return m.SubmittedAt
+	}
+	return 0
+}
+
+// Dispute is a challenge against a claim.
+type Dispute struct {
+	Id                 uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClaimId            uint64           `protobuf:"varint,2,opt,name=claim_id,json=claimId,proto3" json:"claim_id,omitempty"`
+	Challenger         string           `protobuf:"bytes,3,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	Node               string           `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+	Bond               types.Coin       `protobuf:"bytes,5,opt,name=bond,proto3" json:"bond"`
+	Reason             string           `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status             DisputeStatus    `protobuf:"varint,7,opt,name=status,proto3,enum=contactical.reality.v1.DisputeStatus" json:"status,omitempty"`
+	CreatedAt          int64            `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResponseDeadline   int64            `protobuf:"varint,9,opt,name=response_deadline,json=responseDeadline,proto3" json:"response_deadline,omitempty"`
+	ResolutionDeadline int64            `protobuf:"varint,10,opt,name=resolution_deadline,json=resolutionDeadline,proto3" json:"resolution_deadline,omitempty"`
+	Evidence           *DisputeEvidence `protobuf:"bytes,11,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	Resolver           string           `protobuf:"bytes,12,opt,name=resolver,proto3" json:"resolver,omitempty"`
+	Rationale          string           `protobuf:"bytes,13,opt,name=rationale,proto3" json:"rationale,omitempty"`
+	ResolvedAt         int64            `protobuf:"varint,14,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	// slashed is the part of the loser's bond that was burned: the challenger's
+	// dispute bond when rejected, the node and relayer bonds when upheld.
+	Slashed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,15,rep,name=slashed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"slashed"`
+	// clawed_back is the unvested node reward burned for an upheld dispute.
+	ClawedBack github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,16,rep,name=clawed_back,json=clawedBack,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawed_back"`
+}
+
+func (m *Dispute) Reset()         { *m = Dispute{} }
+func (m *Dispute) String() string { return proto.CompactTextString(m) }
+func (*Dispute) ProtoMessage()    {}
+func (*Dispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91eca97ba8476592, []int{1}
+}
+func (m *Dispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Dispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Dispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Dispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dispute.Merge(m, src)
+}
+func (m *Dispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *Dispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Dispute proto.InternalMessageInfo
+
+func (m *Dispute) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Dispute) GetClaimId() uint64 {
+	if m != nil {
+		return m.ClaimId
+	}
+	return 0
+}
+
+func (m *Dispute) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
+	}
+	return ""
+}
+
+func (m *Dispute) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *Dispute) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
+func (m *Dispute) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Dispute) GetStatus() DisputeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DISPUTE_STATUS_UNSPECIFIED
+}
+
+func (m *Dispute) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Dispute) GetResponseDeadline() int64 {
+	if m != nil {
+		return m.ResponseDeadline
+	}
+	return 0
+}
+
+func (m *Dispute) GetResolutionDeadline() int64 {
+	if m != nil {
+		return m.ResolutionDeadline
+	}
+	return 0
+}
+
+func (m *Dispute) GetEvidence() *DisputeEvidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+func (m *Dispute) GetResolver() string {
+	if m != nil {
+		return m.Resolver
+	}
+	return ""
+}
+
+func (m *Dispute) GetRationale() string {
+	if m != nil {
+		return m.Rationale
+	}
+	return ""
+}
+
+func (m *Dispute) GetResolvedAt() int64 {
+	if m != nil {
+		return m.ResolvedAt
+	}
+	return 0
+}
+
+func (m *Dispute) GetSlashed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Slashed
+	}
+	return nil
+}
+
+func (m *Dispute) GetClawedBack() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClawedBack
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("contactical.reality.v1.DisputeStatus", DisputeStatus_name, DisputeStatus_value)
+	proto.RegisterType((*DisputeEvidence)(nil), "contactical.reality.v1.DisputeEvidence")
+	proto.RegisterType((*Dispute)(nil), "contactical.reality.v1.Dispute")
+}
+
+func init() {
+	proto.RegisterFile("contactical/reality/v1/dispute.proto", fileDescriptor_91eca97ba8476592)
+}
+
+var fileDescriptor_91eca97ba8476592 = []byte{
+	// 743 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3d, 0x6f, 0xdb, 0x46,
+	0x18, 0x16, 0x25, 0x59, 0x1f, 0xaf, 0xfc, 0x41, 0x5f, 0x5b, 0x97, 0x96, 0x5b, 0x5a, 0x35, 0xdc,
+	0x56, 0x70, 0x61, 0xb2, 0x72, 0x51, 0xa0, 0x4b, 0x07, 0x59, 0x62, 0x5b, 0x16, 0x86, 0x2c, 0x90,
+	0x12, 0x5a, 0x74, 0x21, 0x4e, 0xe4, 0x41, 0x3e, 0x98, 0xe2, 0x09, 0xbc, 0x93, 0x5c, 0xff, 0x83,
+	0x8e, 0xdd, 0x33, 0x66, 0x09, 0x32, 0xe5, 0x67, 0x78, 0xf4, 0x96, 0x4c, 0x49, 0x60, 0x0f, 0x19,
+	0xf2, 0x27, 0x02, 0x1d, 0x29, 0xd9, 0x11, 0x02, 0x67, 0xc9, 0x22, 0xdd, 0x3d, 0xcf, 0xf3, 0x7e,
+	0xdc, 0x73, 0x2f, 0x0f, 0xf6, 0x7d, 0x16, 0x09, 0xec, 0x0b, 0xea, 0xe3, 0xd0, 0x8c, 0x09, 0x0e,
+	0xa9, 0xb8, 0x34, 0xa7, 0x0d, 0x33, 0xa0, 0x7c, 0x3c, 0x11, 0xc4, 0x18, 0xc7, 0x4c, 0x30, 0xb4,
+	0x75, 0x4f, 0x65, 0xa4, 0x2a, 0x63, 0xda, 0xa8, 0x6e, 0xe2, 0x11, 0x8d, 0x98, 0x29, 0x7f, 0x13,
+	0x69, 0x55, 0xf7, 0x19, 0x1f, 0x31, 0x6e, 0x0e, 0x30, 0x27, 0xe6, 0xb4, 0x31, 0x20, 0x02, 0x37,
+	0x4c, 0x9f, 0xd1, 0x28, 0xe5, 0x3f, 0x1f, 0xb2, 0x21, 0x93, 0x4b, 0x73, 0xb6, 0x4a, 0xd0, 0xbd,
+	0x47, 0x0a, 0x6c, 0xb4, 0x93, 0x92, 0xd6, 0x94, 0x06, 0x24, 0xf2, 0x09, 0xd2, 0xa0, 0x38, 0xc6,
+	0x97, 0x21, 0xc3, 0x81, 0xa6, 0xd4, 0x94, 0x7a, 0xd9, 0x99, 0x6f, 0xd1, 0x21, 0xa0, 0x0b, 0x2a,
+	0x22, 0xc2, 0xb9, 0xc7, 0xe9, 0x30, 0xc2, 0x62, 0x12, 0x13, 0xae, 0x65, 0x6b, 0xb9, 0x7a, 0xd9,
+	0xd9, 0x4c, 0x19, 0x77, 0x41, 0xa0, 0xaf, 0xa0, 0xcc, 0x05, 0x16, 0x64, 0x44, 0x22, 0xa1, 0xe5,
+	0x64, 0xaa, 0x3b, 0x00, 0x7d, 0x03, 0xab, 0x7c, 0x32, 0x18, 0x51, 0x21, 0x48, 0xe0, 0x61, 0xa1,
+	0xe5, 0x6b, 0x4a, 0x3d, 0xe7, 0x54, 0x16, 0x58, 0x53, 0xec, 0xbd, 0x5d, 0x81, 0x62, 0xda, 0x1d,
+	0x5a, 0x87, 0x2c, 0x4d, 0x1a, 0xca, 0x3b, 0x59, 0x1a, 0xa0, 0x6d, 0x28, 0xf9, 0x21, 0xa6, 0x23,
+	0x8f, 0x06, 0x5a, 0x56, 0xa2, 0x45, 0xb9, 0xb7, 0x03, 0xa4, 0x03, 0xf8, 0x67, 0x38, 0x0c, 0x49,
+	0x34, 0x24, 0x71, 0x5a, 0xf8, 0x1e, 0x82, 0x10, 0xe4, 0x23, 0x16, 0x10, 0x59, 0xb1, 0xec, 0xc8,
+	0x35, 0xfa, 0x05, 0xf2, 0x03, 0x16, 0x05, 0xda, 0x4a, 0x4d, 0xa9, 0x57, 0x8e, 0xb6, 0x8d, 0xc4,
+	0x4d, 0x63, 0xe6, 0xa6, 0x91, 0xba, 0x69, 0xb4, 0x18, 0x8d, 0x8e, 0xcb, 0x57, 0x2f, 0x77, 0x33,
+	0x4f, 0xde, 0x3c, 0x3b, 0x50, 0x1c, 0x19, 0x81, 0xb6, 0xa0, 0x10, 0x13, 0xcc, 0x59, 0xa4, 0x15,
+	0x64, 0xbe, 0x74, 0x87, 0x7e, 0x85, 0xc2, 0xec, 0xb0, 0x13, 0xae, 0x15, 0x6b, 0x4a, 0x7d, 0xfd,
+	0xe8, 0x5b, 0xe3, 0xc3, 0x97, 0x69, 0xa4, 0x27, 0x74, 0xa5, 0xd8, 0x49, 0x83, 0xd0, 0xd7, 0x00,
+	0x7e, 0x4c, 0x70, 0x6a, 0x4e, 0x49, 0x9a, 0x53, 0x4e, 0x91, 0xa6, 0x40, 0x3f, 0xc0, 0x66, 0x4c,
+	0xf8, 0x98, 0x45, 0x9c, 0x78, 0x01, 0xc1, 0x41, 0x48, 0x23, 0xa2, 0x95, 0xa5, 0x4a, 0x9d, 0x13,
+	0xed, 0x14, 0x47, 0x26, 0x7c, 0x16, 0x13, 0xce, 0xc2, 0x89, 0xa0, 0x2c, 0xba, 0x93, 0x83, 0x94,
+	0xa3, 0x3b, 0x6a, 0x11, 0xd0, 0x82, 0x12, 0x49, 0xc7, 0x41, 0xab, 0x48, 0x47, 0xbe, 0xff, 0x48,
+	0xf7, 0xf3, 0xe9, 0x71, 0x16, 0x81, 0xa8, 0x0a, 0x25, 0x99, 0x7a, 0x4a, 0x62, 0x6d, 0x55, 0x5a,
+	0xb3, 0xd8, 0xcf, 0x46, 0x23, 0xc6, 0xb3, 0x92, 0x38, 0x24, 0xda, 0x5a, 0x32, 0x1a, 0x0b, 0x00,
+	0xed, 0x42, 0x25, 0x55, 0xca, 0xc3, 0xaf, 0xcb, 0x3e, 0x61, 0x0e, 0x35, 0x05, 0x22, 0x50, 0xe4,
+	0x21, 0xe6, 0x67, 0x24, 0xd0, 0x36, 0x6a, 0xb9, 0x87, 0x2f, 0xec, 0xc7, 0xd9, 0x85, 0x3d, 0x7d,
+	0xb5, 0x5b, 0x1f, 0x52, 0x71, 0x36, 0x19, 0x18, 0x3e, 0x1b, 0x99, 0xe9, 0xb7, 0x92, 0xfc, 0x1d,
+	0xf2, 0xe0, 0xdc, 0x14, 0x97, 0x63, 0xc2, 0x65, 0x00, 0x77, 0xe6, 0xb9, 0x51, 0x08, 0x15, 0x3f,
+	0xc4, 0x17, 0x24, 0xf0, 0x06, 0xd8, 0x3f, 0xd7, 0xd4, 0x4f, 0x5f, 0x0a, 0x92, 0xfc, 0xc7, 0xd8,
+	0x3f, 0x3f, 0x78, 0xae, 0xc0, 0xda, 0x7b, 0xb3, 0x80, 0x74, 0xa8, 0xb6, 0x6d, 0xb7, 0xdb, 0xef,
+	0x59, 0x9e, 0xdb, 0x6b, 0xf6, 0xfa, 0xae, 0xd7, 0xef, 0xb8, 0x5d, 0xab, 0x65, 0xff, 0x66, 0x5b,
+	0x6d, 0x35, 0x83, 0xf6, 0xa1, 0xb6, 0xc4, 0x37, 0xff, 0x6a, 0xda, 0x3d, 0xbb, 0xf3, 0xbb, 0xe7,
+	0x58, 0x6e, 0xf7, 0xb4, 0xe3, 0x5a, 0xaa, 0x82, 0xbe, 0x83, 0xbd, 0x07, 0x54, 0xa7, 0x27, 0xfd,
+	0x9e, 0x7d, 0xda, 0x51, 0xb3, 0x68, 0x1b, 0xbe, 0x58, 0xae, 0xd6, 0xfd, 0xc3, 0x3a, 0x69, 0xab,
+	0x39, 0xb4, 0x03, 0x5f, 0x2e, 0x51, 0x8e, 0xf5, 0xa7, 0xd5, 0xea, 0x59, 0x6d, 0x35, 0x8f, 0xaa,
+	0xb0, 0xb5, 0x44, 0x5a, 0x7f, 0x77, 0x6d, 0xc7, 0x6a, 0xab, 0x2b, 0xd5, 0xfc, 0x7f, 0x8f, 0xf5,
+	0xcc, 0xf1, 0xcf, 0x57, 0x37, 0xba, 0x72, 0x7d, 0xa3, 0x2b, 0xaf, 0x6f, 0x74, 0xe5, 0xff, 0x5b,
+	0x3d, 0x73, 0x7d, 0xab, 0x67, 0x5e, 0xdc, 0xea, 0x99, 0x7f, 0x76, 0xee, 0x3f, 0x83, 0xff, 0x2e,
+	0x1e, 0x42, 0x69, 0xd1, 0xa0, 0x20, 0xdf, 0xa8, 0x9f, 0xde, 0x05, 0x00, 0x00, 0xff, 0xff, 0x94,
+	0x7a, 0xa9, 0x9e, 0x2c, 0x05, 0x00, 0x00,
+}
+
+func (m *DisputeEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisputeEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisputeEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmittedAt != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.SubmittedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Statement) > 0 {
+		i -= len(m.Statement)
+		copy(dAtA[i:], m.Statement)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Statement)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WitnessSignatures) > 0 {
+		for iNdEx := len(m.WitnessSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WitnessSignatures[iNdEx])
+			copy(dAtA[i:], m.WitnessSignatures[iNdEx])
+			i = encodeVarintDispute(dAtA, i, uint64(len(m.WitnessSignatures[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Dispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Dispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Dispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClawedBack) > 0 {
+		for iNdEx := len(m.ClawedBack) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClawedBack[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDispute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Slashed) > 0 {
+		for iNdEx := len(m.Slashed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDispute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.ResolvedAt != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.ResolvedAt))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Rationale) > 0 {
+		i -= len(m.Rationale)
+		copy(dAtA[i:], m.Rationale)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Rationale)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Resolver) > 0 {
+		i -= len(m.Resolver)
+		copy(dAtA[i:], m.Resolver)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Resolver)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Evidence != nil {
+		{
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDispute(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ResolutionDeadline != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.ResolutionDeadline))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ResponseDeadline != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.ResponseDeadline))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Status != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDispute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ClaimId != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.ClaimId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDispute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDispute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DisputeEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	if len(m.WitnessSignatures) > 0 {
+		for _, s := range m.WitnessSignatures {
+			l = len(s)
+			n += 1 + l + sovDispute(uint64(l))
+		}
+	}
+	l = len(m.Statement)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	if m.SubmittedAt != 0 {
+		n += 1 + sovDispute(uint64(m.SubmittedAt))
+	}
+	return n
+}
+
+func (m *Dispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDispute(uint64(m.Id))
+	}
+	if m.ClaimId != 0 {
+		n += 1 + sovDispute(uint64(m.ClaimId))
+	}
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovDispute(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovDispute(uint64(m.Status))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovDispute(uint64(m.CreatedAt))
+	}
+	if m.ResponseDeadline != 0 {
+		n += 1 + sovDispute(uint64(m.ResponseDeadline))
+	}
+	if m.ResolutionDeadline != 0 {
+		n += 1 + sovDispute(uint64(m.ResolutionDeadline))
+	}
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = len(m.Resolver)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = len(m.Rationale)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	if m.ResolvedAt != 0 {
+		n += 1 + sovDispute(uint64(m.ResolvedAt))
+	}
+	if len(m.Slashed) > 0 {
+		for _, e := range m.Slashed {
+			l = e.Size()
+			n += 1 + l + sovDispute(uint64(l))
+		}
+	}
+	if len(m.ClawedBack) > 0 {
+		for _, e := range m.ClawedBack {
+			l = e.Size()
+			n += 2 + l + sovDispute(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDispute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDispute(x uint64) (n int) {
+	return sovDispute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DisputeEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDispute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisputeEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisputeEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WitnessSignatures", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WitnessSignatures = append(m.WitnessSignatures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+			}
+			m.SubmittedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDispute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Dispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDispute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimId", wireType)
+			}
+			m.ClaimId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DisputeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseDeadline", wireType)
+			}
+			m.ResponseDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionDeadline", wireType)
+			}
+			m.ResolutionDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolutionDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &DisputeEvidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resolver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rationale", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rationale = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAt", wireType)
+			}
+			m.ResolvedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashed = append(m.Slashed, types.Coin{})
+			if err := m.Slashed[len(m.Slashed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawedBack = append(m.ClawedBack, types.Coin{})
+			if err := m.ClawedBack[len(m.ClawedBack)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDispute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDispute(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDispute
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDispute
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDispute
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDispute
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDispute        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDispute          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDispute = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,73 @@
+package types
+
+import "cosmossdk.io/math"
+
+// MaxEmissionProjection bounds the number of epochs returned by the
+// EmissionProjection query.
+const MaxEmissionProjection = 1000
+
+// DefaultEmissionState returns the emission state of a fresh chain.
+func DefaultEmissionState() EmissionState {
+	return EmissionState{
+		TotalMinted:     math.ZeroInt(),
+		TotalWithdrawn:  math.ZeroInt(),
+		TotalClawedBack: math.ZeroInt(),
+	}
+}
+
+// Normalize replaces unset totals with zero, e.g. for state written before a
+// total was tracked.
+func (s EmissionState) Normalize() EmissionState {
+	for _, total := range []*math.Int{&s.TotalMinted, &s.TotalWithdrawn, &s.TotalClawedBack} {
+		if total.IsNil() {
+			*total = math.ZeroInt()
+		}
+	}
+	return s
+}
+
+// EmissionAt returns the scheduled emission of the epoch with the given
+// schedule index before the supply cap is applied. The initial emission is
+// halved every HalvingInterval epochs.
+func (p Params) EmissionAt(index uint64) math.Int {
+	if p.HalvingInterval == 0 {
+		return p.EpochEmission.Amount
+	}
+	halvings := index / p.HalvingInterval
+	if halvings >= uint64(p.EpochEmission.Amount.BigInt().BitLen()) {
+		return math.ZeroInt()
+	}
+	amount := p.EpochEmission.Amount.BigInt()
+	return math.NewIntFromBigInt(amount.Rsh(amount, uint(halvings)))
+}
+
+// RemainingEmission returns how much can still be minted under the hard cap.
+func (p Params) RemainingEmission(minted math.Int) math.Int {
+	if minted.GTE(p.MaxTotalEmission) {
+		return math.ZeroInt()
+	}
+	return p.MaxTotalEmission.Sub(minted)
+}
+
+// EpochBudget returns the emission budget of the next epoch given the current
+// emission state.
+func (p Params) EpochBudget(state EmissionState) math.Int {
+	return math.MinInt(p.EmissionAt(state.EpochsElapsed), p.RemainingEmission(state.TotalMinted))
+}
+
+// ProjectEmissions projects the schedule over the next n epochs assuming every
+// epoch budget is fully paid out.
+func (p Params) ProjectEmissions(state EmissionState, n uint32) []EmissionProjection {
+	projections := make([]EmissionProjection, 0, n)
+	for i := uint32(0); i < n; i++ {
+		emission := p.EpochBudget(state)
+		state.TotalMinted = state.TotalMinted.Add(emission)
+		projections = append(projections, EmissionProjection{
+			EpochIndex: state.EpochsElapsed,
+			Emission:   emission,
+			Cumulative: state.TotalMinted,
+		})
+		state.EpochsElapsed++
+	}
+	return projections
+}
@@ -0,0 +1,714 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: contactical/reality/v1/emission.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EmissionState tracks the progress of the reward emission schedule.
+type EmissionState struct {
+	// epochs_elapsed is the number of reality epochs that ended so far.
+	EpochsElapsed uint64 `protobuf:"varint,1,opt,name=epochs_elapsed,json=epochsElapsed,proto3" json:"epochs_elapsed,omitempty"`
+	// total_minted is the cumulative amount of rewards minted by the module.
+	TotalMinted cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_minted,json=totalMinted,proto3,customtype=cosmossdk.io/math.Int" json:"total_minted"`
+	// total_withdrawn is the cumulative amount of vested rewards paid to nodes.
+	TotalWithdrawn cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_withdrawn,json=totalWithdrawn,proto3,customtype=cosmossdk.io/math.Int" json:"total_withdrawn"`
+	// total_clawed_back is the cumulative amount of unvested rewards burned by clawbacks.
+	TotalClawedBack cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_clawed_back,json=totalClawedBack,proto3,customtype=cosmossdk.io/math.Int" json:"total_clawed_back"`
+}
+
+func (m *EmissionState) Reset()         { *m = EmissionState{} }
+func (m *EmissionState) String() string { return proto.CompactTextString(m) }
+func (*EmissionState) ProtoMessage()    {}
+func (*EmissionState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21883e125952812b, []int{0}
+}
+func (m *EmissionState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionState.Merge(m, src)
+}
+func (m *EmissionState) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionState) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionState proto.InternalMessageInfo
+
+func (m *EmissionState) GetEpochsElapsed() uint64 {
+	if m != nil {
+		return m.EpochsElapsed
+	}
+	return 0
+}
+
+// EmissionProjection is the scheduled emission of a future epoch.
+type EmissionProjection struct {
+	// epoch_index is the schedule index (epochs_elapsed) of the epoch.
+	EpochIndex uint64                `protobuf:"varint,1,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	Emission   cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=emission,proto3,customtype=cosmossdk.io/math.Int" json:"emission"`
+	// cumulative is the total minted supply once this epoch has been paid out.
+	Cumulative cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=cumulative,proto3,customtype=cosmossdk.io/math.Int" json:"cumulative"`
+}
+
+func (m *EmissionProjection) Reset()         { *m = EmissionProjection{} }
+func (m *EmissionProjection) String() string { return proto.CompactTextString(m) }
+func (*EmissionProjection) ProtoMessage()    {}
+func (*EmissionProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21883e125952812b, []int{1}
+}
+func (m *EmissionProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionProjection.Merge(m, src)
+}
+func (m *EmissionProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionProjection proto.InternalMessageInfo
+
+func (m *EmissionProjection) GetEpochIndex() uint64 {
+	if m != nil {
+		return m.EpochIndex
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EmissionState)(nil), "contactical.reality.v1.EmissionState")
+	proto.RegisterType((*EmissionProjection)(nil), "contactical.reality.v1.EmissionProjection")
+}
+
+func init() {
+	proto.RegisterFile("contactical/reality/v1/emission.proto", fileDescriptor_21883e125952812b)
+}
+
+var fileDescriptor_21883e125952812b = []byte{
+	// 374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xdf, 0x6a, 0x1a, 0x41,
+	0x18, 0xc5, 0x77, 0xad, 0x94, 0x76, 0xac, 0x96, 0x0e, 0x6d, 0xd9, 0x5a, 0x58, 0x45, 0x10, 0x84,
+	0xd2, 0x5d, 0xa4, 0xf4, 0x05, 0x2c, 0x52, 0x24, 0x24, 0x04, 0x13, 0x10, 0x72, 0xb3, 0x8c, 0xb3,
+	0x83, 0x4e, 0xdc, 0x9d, 0x59, 0x76, 0x3e, 0xff, 0xbd, 0x45, 0x1e, 0x26, 0x79, 0x07, 0x21, 0x37,
+	0x92, 0xab, 0x90, 0x0b, 0x09, 0xfa, 0x22, 0xc1, 0x99, 0x55, 0xbc, 0xd5, 0xbb, 0x99, 0xc3, 0x77,
+	0x7e, 0xf3, 0x71, 0xe6, 0xa0, 0x3a, 0x95, 0x02, 0x08, 0x05, 0x4e, 0x49, 0xe4, 0xa7, 0x8c, 0x44,
+	0x1c, 0xe6, 0xfe, 0xa4, 0xe9, 0xb3, 0x98, 0x2b, 0xc5, 0xa5, 0xf0, 0x92, 0x54, 0x82, 0xc4, 0xdf,
+	0x0f, 0xc6, 0xbc, 0x6c, 0xcc, 0x9b, 0x34, 0xcb, 0x3f, 0xa8, 0x54, 0xb1, 0x54, 0x81, 0x9e, 0xf2,
+	0xcd, 0xc5, 0x58, 0xca, 0x5f, 0x07, 0x72, 0x20, 0x8d, 0xbe, 0x3d, 0x19, 0xb5, 0xf6, 0x90, 0x43,
+	0xc5, 0x76, 0xc6, 0xbe, 0x02, 0x02, 0x0c, 0xd7, 0x51, 0x89, 0x25, 0x92, 0x0e, 0x55, 0xc0, 0x22,
+	0x92, 0x28, 0x16, 0x3a, 0x76, 0xd5, 0x6e, 0xe4, 0xbb, 0x45, 0xa3, 0xb6, 0x8d, 0x88, 0x2f, 0xd0,
+	0x27, 0x90, 0x40, 0xa2, 0x20, 0xe6, 0x02, 0x58, 0xe8, 0xe4, 0xaa, 0x76, 0xe3, 0x63, 0xeb, 0xd7,
+	0x62, 0x55, 0xb1, 0x5e, 0x56, 0x95, 0x6f, 0xe6, 0x69, 0x15, 0x8e, 0x3c, 0x2e, 0xfd, 0x98, 0xc0,
+	0xd0, 0xeb, 0x08, 0x78, 0xba, 0xff, 0x8d, 0xb2, 0x9d, 0x3a, 0x02, 0xba, 0x05, 0x0d, 0x38, 0xd7,
+	0x7e, 0x7c, 0x8d, 0x3e, 0x1b, 0xde, 0x94, 0xc3, 0x30, 0x4c, 0xc9, 0x54, 0x38, 0xef, 0x8e, 0x47,
+	0x96, 0x34, 0xa3, 0xb7, 0x43, 0xe0, 0x1e, 0xfa, 0x62, 0xa8, 0x34, 0x22, 0x53, 0x16, 0x06, 0x7d,
+	0x42, 0x47, 0x4e, 0xfe, 0x78, 0xae, 0xd9, 0xed, 0x9f, 0x86, 0xb4, 0x08, 0x1d, 0xd5, 0x1e, 0x6d,
+	0x84, 0x77, 0xb9, 0x5d, 0xa6, 0xf2, 0x96, 0x51, 0xe0, 0x52, 0xe0, 0x0a, 0x2a, 0xe8, 0x98, 0x02,
+	0x2e, 0x42, 0x36, 0xcb, 0x92, 0x43, 0x5a, 0xea, 0x6c, 0x15, 0xfc, 0x1f, 0x7d, 0xd8, 0x7d, 0xe5,
+	0x29, 0x91, 0xed, 0xcd, 0xf8, 0x0c, 0x21, 0x3a, 0x8e, 0xc7, 0x11, 0x01, 0x3e, 0x61, 0xa7, 0x44,
+	0x75, 0x60, 0x6f, 0xfd, 0x5d, 0xac, 0x5d, 0x7b, 0xb9, 0x76, 0xed, 0xd7, 0xb5, 0x6b, 0xdf, 0x6d,
+	0x5c, 0x6b, 0xb9, 0x71, 0xad, 0xe7, 0x8d, 0x6b, 0xdd, 0xfc, 0x3c, 0xec, 0xe3, 0x6c, 0xdf, 0x48,
+	0x98, 0x27, 0x4c, 0xf5, 0xdf, 0xeb, 0x0e, 0xfd, 0x79, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x36, 0x87,
+	0x07, 0xd8, 0xb5, 0x02, 0x00, 0x00,
+}
+
+func (m *EmissionState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalClawedBack.Size()
+		i -= size
+		if _, err := m.TotalClawedBack.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEmission(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalWithdrawn.Size()
+		i -= size
+		if _, err := m.TotalWithdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEmission(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalMinted.Size()
+		i -= size
+		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEmission(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EpochsElapsed != 0 {
+		i = encodeVarintEmission(dAtA, i, uint64(m.EpochsElapsed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Cumulative.Size()
+		i -= size
+		if _, err := m.Cumulative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEmission(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Emission.Size()
+		i -= size
+		if _, err := m.Emission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEmission(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EpochIndex != 0 {
+		i = encodeVarintEmission(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEmission(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEmission(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EmissionState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochsElapsed != 0 {
+		n += 1 + sovEmission(uint64(m.EpochsElapsed))
+	}
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovEmission(uint64(l))
+	l = m.TotalWithdrawn.Size()
+	n += 1 + l + sovEmission(uint64(l))
+	l = m.TotalClawedBack.Size()
+	n += 1 + l + sovEmission(uint64(l))
+	return n
+}
+
+func (m *EmissionProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochIndex != 0 {
+		n += 1 + sovEmission(uint64(m.EpochIndex))
+	}
+	l = m.Emission.Size()
+	n += 1 + l + sovEmission(uint64(l))
+	l = m.Cumulative.Size()
+	n += 1 + l + sovEmission(uint64(l))
+	return n
+}
+
+func sovEmission(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEmission(x uint64) (n int) {
+	return sovEmission(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EmissionState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmission
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsElapsed", wireType)
+			}
+			m.EpochsElapsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsElapsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmission
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmission
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWithdrawn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmission
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmission
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalWithdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalClawedBack", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmission
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmission
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalClawedBack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmission(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEmission
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmission
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			}
+			m.EpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmission
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmission
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Emission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cumulative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmission
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmission
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmission(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEmission
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEmission(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEmission
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEmission
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEmission
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEmission
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEmission
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEmission
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEmission        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEmission          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEmission = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// Reward tally kinds used to bucket the per-epoch reward distribution.
+const (
+	TallyKindNode    = "node"
+	TallyKindRegion  = "region"
+	TallyKindRelayer = "relayer"
+)
+
+// log2Iterations is the number of fractional bits computed by Log2.
+// LegacyDec carries 18 decimals (~60 bits) so more iterations add nothing.
+const log2Iterations = 60
+
+var two = math.LegacyNewDec(2)
+
+// Log2 returns the base-2 logarithm of a positive decimal. It only uses
+// LegacyDec arithmetic so every validator derives the same value.
+func Log2(x math.LegacyDec) (math.LegacyDec, error) {
+	if x.IsNil() || !x.IsPositive() {
+		return math.LegacyDec{}, fmt.Errorf("log2 of non-positive value: %s", x)
+	}
+
+	// Normalize x into [1, 2) and count the integer part.
+	result := math.LegacyZeroDec()
+	y := x
+	for y.GTE(two) {
+		y = y.Quo(two)
+		result = result.Add(math.LegacyOneDec())
+	}
+	for y.LT(math.LegacyOneDec()) {
+		y = y.Mul(two)
+		result = result.Sub(math.LegacyOneDec())
+	}
+
+	// Fractional bits by repeated squaring.
+	bit := math.LegacyOneDec()
+	for i := 0; i < log2Iterations; i++ {
+		y = y.Mul(y)
+		bit = bit.Quo(two)
+		if y.GTE(two) {
+			y = y.Quo(two)
+			result = result.Add(bit)
+		}
+	}
+	return result, nil
+}
+
+// NormalizedEntropy returns the Shannon entropy of a reward distribution
+// divided by its maximum (log2 N), so 1 means perfectly even and 0 means a
+// single participant received everything. Non-positive amounts are ignored.
+func NormalizedEntropy(amounts []int64) (math.LegacyDec, error) {
+	total := int64(0)
+	count := int64(0)
+	for _, amount := range amounts {
+		if amount > 0 {
+			total += amount
+			count++
+		}
+	}
+	if count <= 1 {
+		return math.LegacyZeroDec(), nil
+	}
+
+	totalDec := math.LegacyNewDec(total)
+	entropy := math.LegacyZeroDec()
+	for _, amount := range amounts {
+		if amount <= 0 {
+			continue
+		}
+		share := math.LegacyNewDec(amount).Quo(totalDec)
+		// -p * log2(p) == p * log2(total / amount)
+		bits, err := Log2(totalDec.QuoInt64(amount))
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+		entropy = entropy.Add(share.Mul(bits))
+	}
+
+	maxEntropy, err := Log2(math.LegacyNewDec(count))
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	normalized := entropy.Quo(maxEntropy)
+	if normalized.GT(math.LegacyOneDec()) {
+		normalized = math.LegacyOneDec()
+	}
+	return normalized, nil
+}
+
+// AdaptiveWeight returns the reward weight of a participant that received
+// amount out of total across count participants. Participants below the mean
+// share are boosted and those above are damped, bounded by maxBoost.
+func AdaptiveWeight(amount, total, count int64, maxBoost math.LegacyDec) math.LegacyDec {
+	minWeight := math.LegacyOneDec().Quo(maxBoost)
+	if amount <= 0 || count <= 0 {
+		return maxBoost
+	}
+
+	// weight = mean / amount = total / (count * amount)
+	weight := math.LegacyNewDec(total).Quo(math.LegacyNewDec(count).MulInt64(amount))
+	switch {
+	case weight.GT(maxBoost):
+		return maxBoost
+	case weight.LT(minWeight):
+		return minWeight
+	}
+	return weight
+}
@@ -0,0 +1,1211 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: contactical/reality/v1/entropy.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EntropySnapshot is the decentralization metric of one epoch.
+// Entropy values are normalized Shannon entropy (H / log2 N) in [0, 1],
+// where 1 means rewards were spread evenly across all participants.
+type EntropySnapshot struct {
+	EpochNumber    int64                       `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	BlockHeight    int64                       `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	NodeEntropy    cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=node_entropy,json=nodeEntropy,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"node_entropy"`
+	RegionEntropy  cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=region_entropy,json=regionEntropy,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"region_entropy"`
+	RelayerEntropy cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=relayer_entropy,json=relayerEntropy,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"relayer_entropy"`
+	NodeCount      uint64                      `protobuf:"varint,6,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	RegionCount    uint64                      `protobuf:"varint,7,opt,name=region_count,json=regionCount,proto3" json:"region_count,omitempty"`
+	RelayerCount   uint64                      `protobuf:"varint,8,opt,name=relayer_count,json=relayerCount,proto3" json:"relayer_count,omitempty"`
+	TotalRewards   int64                       `protobuf:"varint,9,opt,name=total_rewards,json=totalRewards,proto3" json:"total_rewards,omitempty"`
+	// adaptive_weighting is true when the snapshot fell below the entropy target
+	// and reward weights were adjusted for the next epoch.
+	AdaptiveWeighting bool `protobuf:"varint,10,opt,name=adaptive_weighting,json=adaptiveWeighting,proto3" json:"adaptive_weighting,omitempty"`
+}
+
+func (m *EntropySnapshot) Reset()         { *m = EntropySnapshot{} }
+func (m *EntropySnapshot) String() string { return proto.CompactTextString(m) }
+func (*EntropySnapshot) ProtoMessage()    {}
+func (*EntropySnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fdf89a3c6bec3b9, []int{0}
+}
+func (m *EntropySnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntropySnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntropySnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EntropySnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntropySnapshot.Merge(m, src)
+}
+func (m *EntropySnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntropySnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntropySnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntropySnapshot proto.InternalMessageInfo
+
+func (m *EntropySnapshot) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EntropySnapshot) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EntropySnapshot) GetNodeCount() uint64 {
+	if m != nil {
+		return m.NodeCount
+	}
+	return 0
+}
+
+func (m *EntropySnapshot) GetRegionCount() uint64 {
+	if m != nil {
+		return m.RegionCount
+	}
+	return 0
+}
+
+func (m *EntropySnapshot) GetRelayerCount() uint64 {
+	if m != nil {
+		return m.RelayerCount
+	}
+	return 0
+}
+
+func (m *EntropySnapshot) GetTotalRewards() int64 {
+	if m != nil {
+		return m.TotalRewards
+	}
+	return 0
+}
+
+func (m *EntropySnapshot) GetAdaptiveWeighting() bool {
+	if m != nil {
+		return m.AdaptiveWeighting
+	}
+	return false
+}
+
+// RewardTally is the reward accumulated by one participant in the current epoch.
+type RewardTally struct {
+	Kind   string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Key    string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Amount int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *RewardTally) Reset()         { *m = RewardTally{} }
+func (m *RewardTally) String() string { return proto.CompactTextString(m) }
+func (*RewardTally) ProtoMessage()    {}
+func (*RewardTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fdf89a3c6bec3b9, []int{1}
+}
+func (m *RewardTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardTally.Merge(m, src)
+}
+func (m *RewardTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardTally proto.InternalMessageInfo
+
+func (m *RewardTally) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *RewardTally) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RewardTally) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// RewardWeight is the adaptive reward multiplier applied to a node or region.
+type RewardWeight struct {
+	Kind   string                      `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Key    string                      `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *RewardWeight) Reset()         { *m = RewardWeight{} }
+func (m *RewardWeight) String() string { return proto.CompactTextString(m) }
+func (*RewardWeight) ProtoMessage()    {}
+func (*RewardWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fdf89a3c6bec3b9, []int{2}
+}
+func (m *RewardWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardWeight.Merge(m, src)
+}
+func (m *RewardWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardWeight proto.InternalMessageInfo
+
+func (m *RewardWeight) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *RewardWeight) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EntropySnapshot)(nil), "contactical.reality.v1.EntropySnapshot")
+	proto.RegisterType((*RewardTally)(nil), "contactical.reality.v1.RewardTally")
+	proto.RegisterType((*RewardWeight)(nil), "contactical.reality.v1.RewardWeight")
+}
+
+func init() {
+	proto.RegisterFile("contactical/reality/v1/entropy.proto", fileDescriptor_7fdf89a3c6bec3b9)
+}
+
+var fileDescriptor_7fdf89a3c6bec3b9 = []byte{
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc7, 0x1b, 0x5a, 0xca, 0xfa, 0x6b, 0xb6, 0x81, 0x85, 0xa6, 0xb0, 0x89, 0xac, 0x14, 0x0e,
+	0xbd, 0x2c, 0x51, 0x85, 0x78, 0x81, 0x31, 0x24, 0x10, 0x88, 0x43, 0x98, 0x04, 0xda, 0x25, 0x72,
+	0x1d, 0x2b, 0x89, 0x9a, 0xda, 0x91, 0xe3, 0x75, 0xe4, 0xc4, 0x2b, 0xf0, 0x04, 0x3c, 0x05, 0x0f,
+	0xb1, 0xe3, 0xc4, 0x09, 0x71, 0x98, 0x50, 0xfb, 0x22, 0x28, 0xfe, 0x39, 0xd5, 0x8e, 0xa8, 0x37,
+	0xe7, 0xe3, 0xaf, 0x3f, 0xfa, 0xc6, 0x7f, 0xe0, 0x05, 0x93, 0x42, 0x53, 0xa6, 0x73, 0x46, 0x8b,
+	0x50, 0x71, 0x5a, 0xe4, 0xba, 0x0e, 0x97, 0xd3, 0x90, 0x0b, 0xad, 0x64, 0x59, 0x07, 0xa5, 0x92,
+	0x5a, 0x92, 0x83, 0x3b, 0xa9, 0xc0, 0xa6, 0x82, 0xe5, 0xf4, 0xf0, 0x09, 0x93, 0xd5, 0x42, 0x56,
+	0xb1, 0x49, 0x85, 0xf8, 0x81, 0x4b, 0x0e, 0x1f, 0xa7, 0x32, 0x95, 0xc8, 0x9b, 0x11, 0xd2, 0xf1,
+	0x8f, 0x1e, 0xec, 0xbf, 0x41, 0xf5, 0x27, 0x41, 0xcb, 0x2a, 0x93, 0x9a, 0x3c, 0x03, 0x97, 0x97,
+	0x92, 0x65, 0xb1, 0xb8, 0x5c, 0xcc, 0xb8, 0xf2, 0x9c, 0x91, 0x33, 0xe9, 0x46, 0x43, 0xc3, 0x3e,
+	0x1a, 0xd4, 0x44, 0x66, 0x85, 0x64, 0xf3, 0x38, 0xe3, 0x79, 0x9a, 0x69, 0xef, 0x1e, 0x46, 0x0c,
+	0x7b, 0x6b, 0x10, 0x39, 0x07, 0x57, 0xc8, 0x84, 0xc7, 0xb6, 0xb8, 0xd7, 0x1d, 0x39, 0x93, 0xc1,
+	0xe9, 0xf4, 0xfa, 0xf6, 0xb8, 0xf3, 0xe7, 0xf6, 0xf8, 0x08, 0xbb, 0x55, 0xc9, 0x3c, 0xc8, 0x65,
+	0xb8, 0xa0, 0x3a, 0x0b, 0x3e, 0xf0, 0x94, 0xb2, 0xfa, 0x8c, 0xb3, 0x5f, 0x3f, 0x4f, 0xc0, 0x56,
+	0x3f, 0xe3, 0x2c, 0x1a, 0x36, 0x1a, 0xdb, 0x91, 0x7c, 0x81, 0x3d, 0xc5, 0xd3, 0x5c, 0x8a, 0x8d,
+	0xb7, 0xb7, 0xad, 0x77, 0x17, 0x45, 0xad, 0xf9, 0x02, 0xf6, 0x15, 0x2f, 0x68, 0xcd, 0xd5, 0x46,
+	0x7d, 0x7f, 0x5b, 0xf5, 0x9e, 0x35, 0xb5, 0xee, 0xa7, 0x00, 0x66, 0x2f, 0x98, 0xbc, 0x14, 0xda,
+	0xeb, 0x8f, 0x9c, 0x49, 0x2f, 0x1a, 0x34, 0xe4, 0x75, 0x03, 0x9a, 0xdd, 0xb4, 0x3f, 0x85, 0x81,
+	0x07, 0x26, 0x30, 0x44, 0x86, 0x91, 0xe7, 0xb0, 0xdb, 0xb6, 0xc3, 0xcc, 0x8e, 0xc9, 0xb8, 0x16,
+	0x6e, 0x42, 0x5a, 0x6a, 0x5a, 0xc4, 0x8a, 0x5f, 0x51, 0x95, 0x54, 0xde, 0xc0, 0x1c, 0x8b, 0x6b,
+	0x60, 0x84, 0x8c, 0x9c, 0x00, 0xa1, 0x09, 0x2d, 0x75, 0xbe, 0xe4, 0xf1, 0x95, 0x39, 0xaa, 0x5c,
+	0xa4, 0x1e, 0x8c, 0x9c, 0xc9, 0x4e, 0xf4, 0xa8, 0x9d, 0xf9, 0xdc, 0x4e, 0x8c, 0xdf, 0xc3, 0x10,
+	0x57, 0x9e, 0xd3, 0xa2, 0xa8, 0x09, 0x81, 0xde, 0x3c, 0x17, 0x89, 0xb9, 0x13, 0x83, 0xc8, 0x8c,
+	0xc9, 0x43, 0xe8, 0xce, 0x79, 0x6d, 0xee, 0xc0, 0x20, 0x6a, 0x86, 0xe4, 0x00, 0xfa, 0x74, 0x61,
+	0x6a, 0x76, 0x4d, 0x03, 0xfb, 0x35, 0xfe, 0x06, 0x2e, 0xca, 0xd0, 0xff, 0x9f, 0xb6, 0x77, 0xd0,
+	0xc7, 0xa2, 0xdb, 0xdf, 0x21, 0x2b, 0x38, 0x7d, 0x75, 0xbd, 0xf2, 0x9d, 0x9b, 0x95, 0xef, 0xfc,
+	0x5d, 0xf9, 0xce, 0xf7, 0xb5, 0xdf, 0xb9, 0x59, 0xfb, 0x9d, 0xdf, 0x6b, 0xbf, 0x73, 0x71, 0x74,
+	0xf7, 0xdd, 0x7d, 0xdd, 0xbc, 0x3c, 0x5d, 0x97, 0xbc, 0x9a, 0xf5, 0xcd, 0x63, 0x79, 0xf9, 0x2f,
+	0x00, 0x00, 0xff, 0xff, 0xd8, 0xff, 0xb9, 0xca, 0x9d, 0x03, 0x00, 0x00,
+}
+
+func (m *EntropySnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntropySnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntropySnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AdaptiveWeighting {
+		i--
+		if m.AdaptiveWeighting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TotalRewards != 0 {
+		i = encodeVarintEntropy(dAtA, i, uint64(m.TotalRewards))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RelayerCount != 0 {
+		i = encodeVarintEntropy(dAtA, i, uint64(m.RelayerCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RegionCount != 0 {
+		i = encodeVarintEntropy(dAtA, i, uint64(m.RegionCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NodeCount != 0 {
+		i = encodeVarintEntropy(dAtA, i, uint64(m.NodeCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.RelayerEntropy.Size()
+		i -= size
+		if _, err := m.RelayerEntropy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEntropy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RegionEntropy.Size()
+		i -= size
+		if _, err := m.RegionEntropy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEntropy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NodeEntropy.Size()
+		i -= size
+		if _, err := m.NodeEntropy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEntropy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintEntropy(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintEntropy(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintEntropy(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintEntropy(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintEntropy(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEntropy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintEntropy(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintEntropy(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEntropy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEntropy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EntropySnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovEntropy(uint64(m.EpochNumber))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEntropy(uint64(m.BlockHeight))
+	}
+	l = m.NodeEntropy.Size()
+	n += 1 + l + sovEntropy(uint64(l))
+	l = m.RegionEntropy.Size()
+	n += 1 + l + sovEntropy(uint64(l))
+	l = m.RelayerEntropy.Size()
+	n += 1 + l + sovEntropy(uint64(l))
+	if m.NodeCount != 0 {
+		n += 1 + sovEntropy(uint64(m.NodeCount))
+	}
+	if m.RegionCount != 0 {
+		n += 1 + sovEntropy(uint64(m.RegionCount))
+	}
+	if m.RelayerCount != 0 {
+		n += 1 + sovEntropy(uint64(m.RelayerCount))
+	}
+	if m.TotalRewards != 0 {
+		n += 1 + sovEntropy(uint64(m.TotalRewards))
+	}
+	if m.AdaptiveWeighting {
+		n += 2
+	}
+	return n
+}
+
+func (m *RewardTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovEntropy(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovEntropy(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEntropy(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *RewardWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovEntropy(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovEntropy(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovEntropy(uint64(l))
+	return n
+}
+
+func sovEntropy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEntropy(x uint64) (n int) {
+	return sovEntropy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EntropySnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntropy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntropySnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntropySnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeEntropy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NodeEntropy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionEntropy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RegionEntropy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerEntropy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RelayerEntropy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeCount", wireType)
+			}
+			m.NodeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionCount", wireType)
+			}
+			m.RegionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerCount", wireType)
+			}
+			m.RelayerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayerCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+			}
+			m.TotalRewards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRewards |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptiveWeighting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AdaptiveWeighting = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntropy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntropy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntropy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntropy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntropy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntropy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEntropy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEntropy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEntropy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEntropy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEntropy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEntropy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEntropy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEntropy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEntropy = fmt.Errorf("proto: unexpected end of group")
+)
@@ -4,9 +4,41 @@ package types
 
 import (
 	"cosmossdk.io/errors"
+	"google.golang.org/grpc/codes"
 )
 
 // x/reality module sentinel errors
 var (
 	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+
+	// 노드 등록 및 검증
+	ErrUnknownNode        = errors.RegisterWithGRPCCode(ModuleName, 1101, codes.NotFound, "node is not registered")
+	ErrNodeBanned         = errors.RegisterWithGRPCCode(ModuleName, 1102, codes.PermissionDenied, "node is banned")
+	ErrNullifierReused    = errors.RegisterWithGRPCCode(ModuleName, 1103, codes.AlreadyExists, "nullifier already used")
+	ErrMissingProof       = errors.RegisterWithGRPCCode(ModuleName, 1104, codes.InvalidArgument, "challenge or nullifier required")
+	ErrAttestationFailed  = errors.RegisterWithGRPCCode(ModuleName, 1105, codes.PermissionDenied, "attestation verification failed")
+	ErrInsufficientBond   = errors.RegisterWithGRPCCode(ModuleName, 1106, codes.FailedPrecondition, "insufficient bond")
+	ErrInvalidBond        = errors.RegisterWithGRPCCode(ModuleName, 1107, codes.InvalidArgument, "invalid bond")
+	ErrExpiredTimestamp   = errors.RegisterWithGRPCCode(ModuleName, 1108, codes.InvalidArgument, "timestamp outside the validity window")
+	ErrInvalidSignature   = errors.RegisterWithGRPCCode(ModuleName, 1109, codes.Unauthenticated, "data signature does not match the node key")
+	ErrPluginVerification = errors.RegisterWithGRPCCode(ModuleName, 1110, codes.PermissionDenied, "plugin verification failed")
+	ErrClaimNotFound      = errors.RegisterWithGRPCCode(ModuleName, 1111, codes.NotFound, "claim not found")
+	ErrNoVestedRewards    = errors.RegisterWithGRPCCode(ModuleName, 1112, codes.FailedPrecondition, "no vested rewards")
+	ErrClaimClawedBack    = errors.RegisterWithGRPCCode(ModuleName, 1113, codes.FailedPrecondition, "claim was already clawed back")
+
+	// 분쟁
+	ErrDisputeNotFound      = errors.RegisterWithGRPCCode(ModuleName, 1120, codes.NotFound, "dispute not found")
+	ErrInvalidDispute       = errors.RegisterWithGRPCCode(ModuleName, 1121, codes.FailedPrecondition, "invalid dispute")
+	ErrResponseWindowClosed = errors.RegisterWithGRPCCode(ModuleName, 1122, codes.DeadlineExceeded, "dispute response window closed")
+
+	// 유동성 풀 및 스왑
+	ErrPoolNotFound          = errors.RegisterWithGRPCCode(ModuleName, 1130, codes.NotFound, "pool not found")
+	ErrPoolExists            = errors.RegisterWithGRPCCode(ModuleName, 1131, codes.AlreadyExists, "pool already exists")
+	ErrInsufficientLiquidity = errors.RegisterWithGRPCCode(ModuleName, 1132, codes.FailedPrecondition, "insufficient liquidity")
+	ErrInvalidRoute          = errors.RegisterWithGRPCCode(ModuleName, 1133, codes.InvalidArgument, "invalid swap route")
+	ErrSwapExpired           = errors.RegisterWithGRPCCode(ModuleName, 1134, codes.DeadlineExceeded, "swap deadline has passed")
+	ErrSlippageExceeded      = errors.RegisterWithGRPCCode(ModuleName, 1135, codes.FailedPrecondition, "slippage limit exceeded")
+
+	// 파라미터
+	ErrParamsImmutable = errors.RegisterWithGRPCCode(ModuleName, 1140, codes.InvalidArgument, "param cannot be changed")
 )
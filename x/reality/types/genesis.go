@@ -1,14 +1,31 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:        DefaultParams(),
-		ClaimList:     []Claim{},
-		NodeList:      []NodeInfo{},
-		NullifierList: []string{},
+		Params:            DefaultParams(),
+		ClaimList:         []Claim{},
+		NodeList:          []NodeInfo{},
+		NullifierList:     []string{},
+		ReputationHistory: []ReputationCheckpoint{},
+		BannedNodeList:    []string{},
+		EntropyHistory:    []EntropySnapshot{},
+		RewardTallies:     []RewardTally{},
+		RewardWeights:     []RewardWeight{},
+		VestingTranches:   []VestingTranche{},
+		EmissionState:     DefaultEmissionState(),
+		DisputeList:       []Dispute{},
+		Bonds:             []Bond{},
+		UnbondingEntries:  []UnbondingEntry{},
+		PoolList:          []Pool{},
+		RetiredNodeList:   []string{},
 	}
 }
 
@@ -45,5 +62,179 @@ func (gs GenesisState) Validate() error {
 		nullifierMap[nullifier] = true
 	}
 
+	// Validate ReputationHistory
+	checkpointMap := make(map[string]bool)
+	for _, elem := range gs.ReputationHistory {
+		key := fmt.Sprintf("%s/%d", elem.Node, elem.EpochNumber)
+		if _, ok := checkpointMap[key]; ok {
+			return fmt.Errorf("duplicated reputation checkpoint for %s", key)
+		}
+		checkpointMap[key] = true
+	}
+
+	// Validate BannedNodeList
+	bannedMap := make(map[string]bool)
+	for _, node := range gs.BannedNodeList {
+		if _, ok := bannedMap[node]; ok {
+			return fmt.Errorf("duplicated banned node")
+		}
+		if _, ok := nodeCreatorMap[node]; ok {
+			return fmt.Errorf("banned node %s cannot be registered", node)
+		}
+		bannedMap[node] = true
+	}
+
+	// Validate RetiredNodeList
+	retiredMap := make(map[string]bool)
+	for _, node := range gs.RetiredNodeList {
+		if _, ok := retiredMap[node]; ok {
+			return fmt.Errorf("duplicated retired node")
+		}
+		retiredMap[node] = true
+	}
+
+	// Validate EntropyHistory
+	epochMap := make(map[int64]bool)
+	for _, elem := range gs.EntropyHistory {
+		if _, ok := epochMap[elem.EpochNumber]; ok {
+			return fmt.Errorf("duplicated entropy snapshot for epoch %d", elem.EpochNumber)
+		}
+		epochMap[elem.EpochNumber] = true
+	}
+
+	// Validate RewardTallies
+	tallyMap := make(map[string]bool)
+	for _, elem := range gs.RewardTallies {
+		switch elem.Kind {
+		case TallyKindNode, TallyKindRegion, TallyKindRelayer:
+		default:
+			return fmt.Errorf("invalid reward tally kind: %s", elem.Kind)
+		}
+		key := elem.Kind + "/" + elem.Key
+		if _, ok := tallyMap[key]; ok {
+			return fmt.Errorf("duplicated reward tally for %s", key)
+		}
+		if elem.Amount < 0 {
+			return fmt.Errorf("reward tally for %s must be non-negative", key)
+		}
+		tallyMap[key] = true
+	}
+
+	// Validate RewardWeights
+	weightMap := make(map[string]bool)
+	for _, elem := range gs.RewardWeights {
+		if elem.Kind != TallyKindNode && elem.Kind != TallyKindRegion {
+			return fmt.Errorf("invalid reward weight kind: %s", elem.Kind)
+		}
+		key := elem.Kind + "/" + elem.Key
+		if _, ok := weightMap[key]; ok {
+			return fmt.Errorf("duplicated reward weight for %s", key)
+		}
+		if elem.Weight.IsNil() || !elem.Weight.IsPositive() {
+			return fmt.Errorf("reward weight for %s must be positive", key)
+		}
+		weightMap[key] = true
+	}
+
+	// Validate EmissionState
+	for _, total := range []math.Int{
+		gs.EmissionState.TotalMinted,
+		gs.EmissionState.TotalWithdrawn,
+		gs.EmissionState.TotalClawedBack,
+	} {
+		if !total.IsNil() && total.IsNegative() {
+			return fmt.Errorf("emission totals must be non-negative: %s", total)
+		}
+	}
+
+	// Validate VestingTranches
+	trancheMap := make(map[string]bool)
+	for _, elem := range gs.VestingTranches {
+		key := fmt.Sprintf("%s/%d", elem.Node, elem.EpochIndex)
+		if _, ok := trancheMap[key]; ok {
+			return fmt.Errorf("duplicated vesting tranche for %s", key)
+		}
+		if elem.EndTime < elem.StartTime {
+			return fmt.Errorf("vesting tranche %s ends before it starts", key)
+		}
+		for _, coins := range []sdk.Coins{elem.VestedBase, elem.Vesting, elem.Withdrawn} {
+			if err := coins.Validate(); err != nil {
+				return fmt.Errorf("invalid vesting tranche %s: %w", key, err)
+			}
+		}
+		trancheMap[key] = true
+	}
+
+	// Validate DisputeList
+	disputeIdMap := make(map[uint64]bool)
+	activeClaimMap := make(map[uint64]bool)
+	for _, elem := range gs.DisputeList {
+		if _, ok := disputeIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for dispute")
+		}
+		if elem.Id >= gs.DisputeCount {
+			return fmt.Errorf("dispute id should be lower or equal than the last id")
+		}
+		if elem.Status == DISPUTE_STATUS_UNSPECIFIED {
+			return fmt.Errorf("dispute %d has no status", elem.Id)
+		}
+		if elem.IsActive() {
+			if _, ok := activeClaimMap[elem.ClaimId]; ok {
+				return fmt.Errorf("claim %d has more than one active dispute", elem.ClaimId)
+			}
+			activeClaimMap[elem.ClaimId] = true
+		}
+		if err := elem.Bond.Validate(); err != nil {
+			return fmt.Errorf("invalid bond of dispute %d: %w", elem.Id, err)
+		}
+		disputeIdMap[elem.Id] = true
+	}
+
+	// Validate Bonds
+	bondMap := make(map[string]bool)
+	for _, elem := range gs.Bonds {
+		if _, ok := bondMap[elem.Owner]; ok {
+			return fmt.Errorf("duplicated bond for %s", elem.Owner)
+		}
+		if err := elem.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid bond of %s: %w", elem.Owner, err)
+		}
+		bondMap[elem.Owner] = true
+	}
+
+	// Validate UnbondingEntries
+	unbondingMap := make(map[string]bool)
+	for _, elem := range gs.UnbondingEntries {
+		key := fmt.Sprintf("%s/%d", elem.Owner, elem.CompletionTime)
+		if _, ok := unbondingMap[key]; ok {
+			return fmt.Errorf("duplicated unbonding entry for %s", key)
+		}
+		if err := elem.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid unbonding entry %s: %w", key, err)
+		}
+		unbondingMap[key] = true
+	}
+
+	// Validate PoolList
+	poolIdMap := make(map[uint64]bool)
+	poolDenomMap := make(map[string]bool)
+	for _, elem := range gs.PoolList {
+		if _, ok := poolIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for pool")
+		}
+		if elem.Id >= gs.PoolCount {
+			return fmt.Errorf("pool id should be lower or equal than the last id")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		pair := elem.ReserveA.Denom + "/" + elem.ReserveB.Denom
+		if _, ok := poolDenomMap[pair]; ok {
+			return fmt.Errorf("duplicated pool for %s", pair)
+		}
+		poolIdMap[elem.Id] = true
+		poolDenomMap[pair] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -27,11 +27,27 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the reality module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params        Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	ClaimList     []Claim    `protobuf:"bytes,2,rep,name=claim_list,json=claimList,proto3" json:"claim_list"`
-	ClaimCount    uint64     `protobuf:"varint,3,opt,name=claim_count,json=claimCount,proto3" json:"claim_count,omitempty"`
-	NodeList      []NodeInfo `protobuf:"bytes,4,rep,name=node_list,json=nodeList,proto3" json:"node_list"`
-	NullifierList []string   `protobuf:"bytes,5,rep,name=nullifier_list,json=nullifierList,proto3" json:"nullifier_list,omitempty"`
+	Params            Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ClaimList         []Claim                `protobuf:"bytes,2,rep,name=claim_list,json=claimList,proto3" json:"claim_list"`
+	ClaimCount        uint64                 `protobuf:"varint,3,opt,name=claim_count,json=claimCount,proto3" json:"claim_count,omitempty"`
+	NodeList          []NodeInfo             `protobuf:"bytes,4,rep,name=node_list,json=nodeList,proto3" json:"node_list"`
+	NullifierList     []string               `protobuf:"bytes,5,rep,name=nullifier_list,json=nullifierList,proto3" json:"nullifier_list,omitempty"`
+	ReputationHistory []ReputationCheckpoint `protobuf:"bytes,6,rep,name=reputation_history,json=reputationHistory,proto3" json:"reputation_history"`
+	BannedNodeList    []string               `protobuf:"bytes,7,rep,name=banned_node_list,json=bannedNodeList,proto3" json:"banned_node_list,omitempty"`
+	EntropyHistory    []EntropySnapshot      `protobuf:"bytes,8,rep,name=entropy_history,json=entropyHistory,proto3" json:"entropy_history"`
+	RewardTallies     []RewardTally          `protobuf:"bytes,9,rep,name=reward_tallies,json=rewardTallies,proto3" json:"reward_tallies"`
+	RewardWeights     []RewardWeight         `protobuf:"bytes,10,rep,name=reward_weights,json=rewardWeights,proto3" json:"reward_weights"`
+	EmissionState     EmissionState          `protobuf:"bytes,12,opt,name=emission_state,json=emissionState,proto3" json:"emission_state"`
+	VestingTranches   []VestingTranche       `protobuf:"bytes,13,rep,name=vesting_tranches,json=vestingTranches,proto3" json:"vesting_tranches"`
+	DisputeList       []Dispute              `protobuf:"bytes,14,rep,name=dispute_list,json=disputeList,proto3" json:"dispute_list"`
+	DisputeCount      uint64                 `protobuf:"varint,15,opt,name=dispute_count,json=disputeCount,proto3" json:"dispute_count,omitempty"`
+	Bonds             []Bond                 `protobuf:"bytes,16,rep,name=bonds,proto3" json:"bonds"`
+	UnbondingEntries  []UnbondingEntry       `protobuf:"bytes,17,rep,name=unbonding_entries,json=unbondingEntries,proto3" json:"unbonding_entries"`
+	PoolList          []Pool                 `protobuf:"bytes,18,rep,name=pool_list,json=poolList,proto3" json:"pool_list"`
+	PoolCount         uint64                 `protobuf:"varint,19,opt,name=pool_count,json=poolCount,proto3" json:"pool_count,omitempty"`
+	// retired_node_list holds nodes removed by retirement, revocation or ban
+	// whose claims remain on chain.
+	RetiredNodeList []string `protobuf:"bytes,20,rep,name=retired_node_list,json=retiredNodeList,proto3" json:"retired_node_list,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -102,6 +118,104 @@ func (m *GenesisState) GetNullifierList() []string {
 	return nil
 }
 
+func (m *GenesisState) GetReputationHistory() []ReputationCheckpoint {
+	if m != nil {
+		return m.ReputationHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBannedNodeList() []string {
+	if m != nil {
+		return m.BannedNodeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEntropyHistory() []EntropySnapshot {
+	if m != nil {
+		return m.EntropyHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardTallies() []RewardTally {
+	if m != nil {
+		return m.RewardTallies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardWeights() []RewardWeight {
+	if m != nil {
+		return m.RewardWeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEmissionState() EmissionState {
+	if m != nil {
+		return m.EmissionState
+	}
+	return EmissionState{}
+}
+
+func (m *GenesisState) GetVestingTranches() []VestingTranche {
+	if m != nil {
+		return m.VestingTranches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDisputeList() []Dispute {
+	if m != nil {
+		return m.DisputeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDisputeCount() uint64 {
+	if m != nil {
+		return m.DisputeCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBonds() []Bond {
+	if m != nil {
+		return m.Bonds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondingEntries() []UnbondingEntry {
+	if m != nil {
+		return m.UnbondingEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolList() []Pool {
+	if m != nil {
+		return m.PoolList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolCount() uint64 {
+	if m != nil {
+		return m.PoolCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRetiredNodeList() []string {
+	if m != nil {
+		return m.RetiredNodeList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "contactical.reality.v1.GenesisState")
 }
@@ -111,29 +225,53 @@ func init() {
 }
 
 var fileDescriptor_fc1557c108126e56 = []byte{
-	// 338 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xce, 0xcf, 0x2b,
-	0x49, 0x4c, 0x2e, 0xc9, 0x4c, 0x4e, 0xcc, 0xd1, 0x2f, 0x4a, 0x4d, 0xcc, 0xc9, 0x2c, 0xa9, 0xd4,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x43, 0x52, 0xa5, 0x07, 0x55, 0xa5, 0x57, 0x66, 0x28, 0x25, 0x98, 0x98, 0x9b, 0x99,
-	0x97, 0xaf, 0x0f, 0x26, 0x21, 0x4a, 0xa5, 0x94, 0x70, 0x18, 0x98, 0x9c, 0x93, 0x98, 0x99, 0x0b,
-	0x55, 0xa3, 0x88, 0x43, 0x4d, 0x5e, 0x7e, 0x4a, 0x2a, 0x54, 0x89, 0x32, 0x0e, 0x25, 0x05, 0x89,
-	0x45, 0x89, 0xb9, 0x50, 0x67, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16,
-	0x44, 0x54, 0x69, 0x11, 0x13, 0x17, 0x8f, 0x3b, 0xc4, 0xf9, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42,
-	0x8e, 0x5c, 0x6c, 0x10, 0x6d, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x72, 0x7a, 0xd8, 0xbd,
-	0xa3, 0x17, 0x00, 0x56, 0xe5, 0xc4, 0x79, 0xe2, 0x9e, 0x3c, 0xc3, 0x8a, 0xe7, 0x1b, 0xb4, 0x18,
-	0x83, 0xa0, 0x1a, 0x85, 0x9c, 0xb8, 0xb8, 0xc0, 0x1e, 0x88, 0xcf, 0xc9, 0x2c, 0x2e, 0x91, 0x60,
-	0x52, 0x60, 0xd6, 0xe0, 0x36, 0x92, 0xc5, 0x65, 0x8c, 0x33, 0x48, 0xa5, 0x13, 0x0b, 0xc8, 0x94,
-	0x20, 0x4e, 0xb0, 0x36, 0x9f, 0xcc, 0xe2, 0x12, 0x21, 0x79, 0x2e, 0x6e, 0x88, 0x19, 0xc9, 0xf9,
-	0xa5, 0x79, 0x25, 0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x10, 0x63, 0x9d, 0x41, 0x22, 0x42,
-	0xce, 0x5c, 0x9c, 0xa0, 0x10, 0x80, 0xd8, 0xc1, 0x02, 0xb6, 0x43, 0x01, 0x97, 0x1d, 0x7e, 0xf9,
-	0x29, 0xa9, 0x9e, 0x79, 0x69, 0xf9, 0x50, 0x6b, 0x38, 0x40, 0x1a, 0xc1, 0xb6, 0xa8, 0x72, 0xf1,
-	0xe5, 0x95, 0xe6, 0xe4, 0x64, 0xa6, 0x65, 0xa6, 0x16, 0x41, 0x4c, 0x62, 0x55, 0x60, 0xd6, 0xe0,
-	0x0c, 0xe2, 0x85, 0x8b, 0x82, 0x94, 0x39, 0x99, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c,
-	0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1,
-	0x1c, 0x43, 0x94, 0x34, 0x72, 0xc8, 0x57, 0xc0, 0xc3, 0xbe, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0x1c, 0xc4, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x15, 0x03, 0x34, 0x37, 0x02,
-	0x00, 0x00,
+	// 733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcd, 0x6e, 0x13, 0x3b,
+	0x14, 0xc7, 0x93, 0xdb, 0xb4, 0xb7, 0xe3, 0x7c, 0xcf, 0xad, 0xae, 0x46, 0x85, 0x4e, 0x43, 0x3f,
+	0x68, 0x54, 0xa1, 0x44, 0x2d, 0x42, 0x62, 0x87, 0x48, 0x40, 0x14, 0x84, 0xaa, 0x92, 0x96, 0x56,
+	0xb0, 0x89, 0xdc, 0x19, 0x37, 0xb1, 0x98, 0xd8, 0xa3, 0xb1, 0x93, 0x92, 0xb7, 0xe0, 0x31, 0x58,
+	0xf2, 0x18, 0x5d, 0x76, 0xc9, 0x0a, 0xa1, 0x76, 0xc1, 0x92, 0x57, 0x40, 0x3e, 0xf6, 0xa4, 0xa9,
+	0x84, 0x9b, 0x4d, 0x34, 0x3a, 0xf9, 0x9f, 0xdf, 0xf9, 0x1f, 0xfb, 0x1c, 0xa3, 0x8d, 0x80, 0x33,
+	0x89, 0x03, 0x49, 0x03, 0x1c, 0x35, 0x13, 0x82, 0x23, 0x2a, 0xc7, 0xcd, 0xd1, 0x4e, 0xb3, 0x47,
+	0x18, 0x11, 0x54, 0x34, 0xe2, 0x84, 0x4b, 0xee, 0xfe, 0x3f, 0xa5, 0x6a, 0x18, 0x55, 0x63, 0xb4,
+	0xb3, 0x5c, 0xc5, 0x03, 0xca, 0x78, 0x13, 0x7e, 0xb5, 0x74, 0xf9, 0x81, 0x05, 0x78, 0xca, 0x59,
+	0x68, 0x24, 0x6b, 0x16, 0x49, 0x10, 0x61, 0x3a, 0x30, 0x1a, 0x9b, 0xaf, 0x90, 0x8a, 0x78, 0x28,
+	0xc9, 0x8c, 0x62, 0x31, 0xe7, 0x91, 0x91, 0x6c, 0x5a, 0x24, 0x64, 0x40, 0x85, 0xa0, 0x9c, 0xcd,
+	0xa8, 0x47, 0x98, 0x4c, 0x78, 0x3c, 0x9e, 0x51, 0x8f, 0xf1, 0x30, 0xb5, 0xb4, 0x6e, 0xb3, 0x84,
+	0x13, 0x3c, 0x30, 0xe7, 0xb9, 0xbc, 0x65, 0x11, 0x25, 0x24, 0x1e, 0x4a, 0x2c, 0x67, 0xdb, 0x4a,
+	0xc8, 0x39, 0x4e, 0xc2, 0x14, 0xb7, 0xd4, 0xe3, 0x3d, 0x0e, 0x9f, 0x4d, 0xf5, 0xa5, 0xa3, 0x6b,
+	0xbf, 0x1d, 0x54, 0x78, 0xa5, 0xaf, 0xf1, 0x50, 0x62, 0x49, 0xdc, 0xe7, 0x68, 0x41, 0xbb, 0xf0,
+	0xb2, 0xb5, 0x6c, 0x3d, 0xbf, 0xeb, 0x37, 0xfe, 0x7e, 0xad, 0x8d, 0x03, 0x50, 0xb5, 0x9c, 0x8b,
+	0x1f, 0xab, 0x99, 0xaf, 0xbf, 0xbe, 0x6d, 0x67, 0x3b, 0x26, 0xd1, 0x6d, 0x21, 0x04, 0xb7, 0xd4,
+	0x8d, 0xa8, 0x90, 0xde, 0x3f, 0xb5, 0xb9, 0x7a, 0x7e, 0x77, 0xc5, 0x86, 0x69, 0x2b, 0x65, 0x2b,
+	0xa7, 0x28, 0x1d, 0x07, 0xd2, 0xde, 0x52, 0x21, 0xdd, 0x55, 0x94, 0xd7, 0x8c, 0x80, 0x0f, 0x99,
+	0xf4, 0xe6, 0x6a, 0xd9, 0x7a, 0xae, 0xa3, 0xb1, 0x6d, 0x15, 0x71, 0xdb, 0xc8, 0x51, 0x07, 0xaa,
+	0x6b, 0xe4, 0xa0, 0x46, 0xcd, 0x56, 0x63, 0x9f, 0x87, 0xe4, 0x35, 0x3b, 0xe3, 0xa6, 0xcc, 0xa2,
+	0x4a, 0x84, 0x2a, 0x9b, 0xa8, 0xc4, 0x86, 0x51, 0x44, 0xcf, 0x28, 0x49, 0x34, 0x69, 0xbe, 0x36,
+	0x57, 0x77, 0x3a, 0xc5, 0x49, 0x14, 0x64, 0x18, 0xb9, 0x37, 0x87, 0xde, 0xed, 0x53, 0x21, 0x79,
+	0x32, 0xf6, 0x16, 0xa0, 0xe8, 0x23, 0x5b, 0xd1, 0xce, 0x24, 0xa3, 0xdd, 0x27, 0xc1, 0xa7, 0x98,
+	0x53, 0x26, 0x8d, 0x81, 0xea, 0x0d, 0x6d, 0x4f, 0xc3, 0xdc, 0x3a, 0xaa, 0x9c, 0x62, 0xc6, 0x48,
+	0xd8, 0xbd, 0xe9, 0xea, 0x5f, 0xf0, 0x52, 0xd2, 0xf1, 0xfd, 0xd4, 0xf3, 0x31, 0x2a, 0x9b, 0x79,
+	0x9b, 0x38, 0x59, 0x04, 0x27, 0x5b, 0x36, 0x27, 0x2f, 0xb5, 0xfc, 0x90, 0xe1, 0x58, 0xf4, 0x79,
+	0x6a, 0xa2, 0x64, 0x28, 0xa9, 0x83, 0x03, 0x54, 0xd2, 0x03, 0xd3, 0x95, 0x38, 0x8a, 0x28, 0x11,
+	0x9e, 0x03, 0xd8, 0x75, 0x7b, 0x83, 0x4a, 0x7d, 0x84, 0xa3, 0x68, 0x6c, 0x90, 0xc5, 0x64, 0x12,
+	0xa2, 0x44, 0xb8, 0xef, 0x26, 0xc4, 0x73, 0x42, 0x7b, 0x7d, 0x29, 0x3c, 0x04, 0xc4, 0x8d, 0xbb,
+	0x89, 0x27, 0x20, 0xbe, 0x8d, 0xd4, 0x31, 0xe1, 0x76, 0x50, 0x29, 0xdd, 0xc9, 0xae, 0x50, 0xf3,
+	0xea, 0x15, 0x60, 0x4a, 0x37, 0xad, 0xbd, 0x1b, 0x35, 0x0c, 0x77, 0xca, 0x24, 0xd3, 0x41, 0xf7,
+	0x04, 0x55, 0x46, 0x44, 0x48, 0xca, 0x7a, 0x5d, 0x99, 0x60, 0x16, 0xf4, 0x89, 0xf0, 0x8a, 0x60,
+	0xf4, 0xa1, 0x8d, 0x7a, 0xac, 0xf5, 0x47, 0x5a, 0x6e, 0xb0, 0xe5, 0xd1, 0xad, 0xa8, 0x70, 0xf7,
+	0x50, 0xc1, 0xbc, 0x44, 0xfa, 0x3e, 0x4b, 0x00, 0x5d, 0xb5, 0x41, 0x5f, 0x68, 0xad, 0xa1, 0xe5,
+	0x4d, 0x2a, 0xdc, 0xf9, 0x3a, 0x2a, 0xa6, 0x24, 0xbd, 0x0f, 0x65, 0xd8, 0x87, 0x14, 0xaf, 0x37,
+	0xe2, 0x29, 0x9a, 0x57, 0xef, 0xa7, 0xf0, 0x2a, 0x50, 0xe7, 0xbe, 0xad, 0x4e, 0x8b, 0xb3, 0xd0,
+	0x14, 0xd1, 0x09, 0xee, 0x07, 0x54, 0x1d, 0x32, 0xf5, 0xa9, 0xce, 0x40, 0x8d, 0x85, 0xba, 0xfd,
+	0xea, 0xdd, 0x47, 0xf0, 0x3e, 0x4d, 0x50, 0xd3, 0x95, 0x0e, 0x40, 0x65, 0x38, 0x1d, 0x55, 0x33,
+	0xf0, 0x0c, 0x39, 0xea, 0x9d, 0xd5, 0x07, 0xe0, 0xde, 0x6d, 0xec, 0x80, 0xf3, 0x28, 0x5d, 0x51,
+	0x95, 0x04, 0xad, 0xaf, 0x20, 0x04, 0x00, 0xdd, 0xf7, 0x7f, 0xd0, 0x37, 0x20, 0x75, 0xd3, 0xdb,
+	0xa8, 0x9a, 0x10, 0x49, 0x93, 0x5b, 0x8b, 0xb3, 0x04, 0x8b, 0x53, 0x36, 0x7f, 0xa4, 0x9b, 0xf3,
+	0x26, 0xb7, 0x98, 0xaf, 0x14, 0x5a, 0x4f, 0x2e, 0xae, 0xfc, 0xec, 0xe5, 0x95, 0x9f, 0xfd, 0x79,
+	0xe5, 0x67, 0xbf, 0x5c, 0xfb, 0x99, 0xcb, 0x6b, 0x3f, 0xf3, 0xfd, 0xda, 0xcf, 0x7c, 0xbc, 0x37,
+	0xfd, 0x8e, 0x7e, 0x9e, 0xbc, 0xa4, 0x72, 0x1c, 0x13, 0x71, 0xba, 0x00, 0xef, 0xe5, 0xe3, 0x3f,
+	0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xfa, 0xfc, 0x7a, 0x0c, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -156,6 +294,180 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RetiredNodeList) > 0 {
+		for iNdEx := len(m.RetiredNodeList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RetiredNodeList[iNdEx])
+			copy(dAtA[i:], m.RetiredNodeList[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RetiredNodeList[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.PoolCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.PoolList) > 0 {
+		for iNdEx := len(m.PoolList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.UnbondingEntries) > 0 {
+		for iNdEx := len(m.UnbondingEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Bonds) > 0 {
+		for iNdEx := len(m.Bonds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bonds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.DisputeCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DisputeCount))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.DisputeList) > 0 {
+		for iNdEx := len(m.DisputeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisputeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.VestingTranches) > 0 {
+		for iNdEx := len(m.VestingTranches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingTranches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size, err := m.EmissionState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.RewardWeights) > 0 {
+		for iNdEx := len(m.RewardWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RewardTallies) > 0 {
+		for iNdEx := len(m.RewardTallies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardTallies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.EntropyHistory) > 0 {
+		for iNdEx := len(m.EntropyHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntropyHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.BannedNodeList) > 0 {
+		for iNdEx := len(m.BannedNodeList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BannedNodeList[iNdEx])
+			copy(dAtA[i:], m.BannedNodeList[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BannedNodeList[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ReputationHistory) > 0 {
+		for iNdEx := len(m.ReputationHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReputationHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.NullifierList) > 0 {
 		for iNdEx := len(m.NullifierList) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.NullifierList[iNdEx])
@@ -251,6 +563,80 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ReputationHistory) > 0 {
+		for _, e := range m.ReputationHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BannedNodeList) > 0 {
+		for _, s := range m.BannedNodeList {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EntropyHistory) > 0 {
+		for _, e := range m.EntropyHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardTallies) > 0 {
+		for _, e := range m.RewardTallies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardWeights) > 0 {
+		for _, e := range m.RewardWeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.EmissionState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.VestingTranches) > 0 {
+		for _, e := range m.VestingTranches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DisputeList) > 0 {
+		for _, e := range m.DisputeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DisputeCount != 0 {
+		n += 1 + sovGenesis(uint64(m.DisputeCount))
+	}
+	if len(m.Bonds) > 0 {
+		for _, e := range m.Bonds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingEntries) > 0 {
+		for _, e := range m.UnbondingEntries {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolList) > 0 {
+		for _, e := range m.PoolList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PoolCount != 0 {
+		n += 2 + sovGenesis(uint64(m.PoolCount))
+	}
+	if len(m.RetiredNodeList) > 0 {
+		for _, s := range m.RetiredNodeList {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -441,6 +827,447 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.NullifierList = append(m.NullifierList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputationHistory = append(m.ReputationHistory, ReputationCheckpoint{})
+			if err := m.ReputationHistory[len(m.ReputationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannedNodeList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BannedNodeList = append(m.BannedNodeList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntropyHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntropyHistory = append(m.EntropyHistory, EntropySnapshot{})
+			if err := m.EntropyHistory[len(m.EntropyHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardTallies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardTallies = append(m.RewardTallies, RewardTally{})
+			if err := m.RewardTallies[len(m.RewardTallies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardWeights = append(m.RewardWeights, RewardWeight{})
+			if err := m.RewardWeights[len(m.RewardWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingTranches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingTranches = append(m.VestingTranches, VestingTranche{})
+			if err := m.VestingTranches[len(m.VestingTranches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisputeList = append(m.DisputeList, Dispute{})
+			if err := m.DisputeList[len(m.DisputeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeCount", wireType)
+			}
+			m.DisputeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bonds = append(m.Bonds, Bond{})
+			if err := m.Bonds[len(m.Bonds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingEntries = append(m.UnbondingEntries, UnbondingEntry{})
+			if err := m.UnbondingEntries[len(m.UnbondingEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolList = append(m.PoolList, Pool{})
+			if err := m.PoolList[len(m.PoolList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCount", wireType)
+			}
+			m.PoolCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredNodeList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiredNodeList = append(m.RetiredNodeList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
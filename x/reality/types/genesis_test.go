@@ -21,7 +21,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		{
 			desc:     "valid genesis state",
-			genState: &types.GenesisState{ClaimList: []types.Claim{{Id: 0}, {Id: 1}}, ClaimCount: 2}, valid: true,
+			genState: &types.GenesisState{Params: types.DefaultParams(), ClaimList: []types.Claim{{Id: 0}, {Id: 1}}, ClaimCount: 2}, valid: true,
 		}, {
 			desc: "duplicated claim",
 			genState: &types.GenesisState{
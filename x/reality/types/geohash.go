@@ -0,0 +1,88 @@
+package types
+
+import "fmt"
+
+// CoordinateScale is the fixed-point factor applied to claim coordinates.
+// Stored value = degrees * CoordinateScale (e.g. 37.123456 -> 37123456).
+const CoordinateScale = 1_000_000
+
+// MaxGeohashPrecision bounds the geohash length used for region bucketing.
+const MaxGeohashPrecision = 12
+
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// EncodeGeohash converts fixed-point claim coordinates into a geohash string of
+// the given precision. It only uses integer arithmetic so every validator
+// derives the same region for the same claim.
+func EncodeGeohash(latitude, longitude int64, precision int) (string, error) {
+	if precision <= 0 || precision > MaxGeohashPrecision {
+		return "", fmt.Errorf("geohash precision must be between 1 and %d: %d", MaxGeohashPrecision, precision)
+	}
+	if latitude < -90*CoordinateScale || latitude > 90*CoordinateScale {
+		return "", fmt.Errorf("latitude out of range: %d", latitude)
+	}
+	if longitude < -180*CoordinateScale || longitude > 180*CoordinateScale {
+		return "", fmt.Errorf("longitude out of range: %d", longitude)
+	}
+
+	// Work on doubled intervals so midpoints stay integral.
+	latMin, latMax := int64(-90*CoordinateScale)*2, int64(90*CoordinateScale)*2
+	lngMin, lngMax := int64(-180*CoordinateScale)*2, int64(180*CoordinateScale)*2
+	lat, lng := latitude*2, longitude*2
+
+	hash := make([]byte, 0, precision)
+	even := true
+	bit, ch := 0, 0
+	for len(hash) < precision {
+		if even {
+			mid := (lngMin + lngMax) / 2
+			if lng >= mid {
+				ch |= 1 << (4 - bit)
+				lngMin = mid
+			} else {
+				lngMax = mid
+			}
+		} else {
+			mid := (latMin + latMax) / 2
+			if lat >= mid {
+				ch |= 1 << (4 - bit)
+				latMin = mid
+			} else {
+				latMax = mid
+			}
+		}
+		even = !even
+
+		if bit < 4 {
+			bit++
+		} else {
+			hash = append(hash, geohashAlphabet[ch])
+			bit, ch = 0, 0
+		}
+	}
+
+	return string(hash), nil
+}
+
+// ValidateGeohash checks that s only contains geohash characters.
+func ValidateGeohash(s string) error {
+	if s == "" {
+		return fmt.Errorf("geohash cannot be empty")
+	}
+	if len(s) > MaxGeohashPrecision {
+		return fmt.Errorf("geohash too long: %d", len(s))
+	}
+	for _, c := range s {
+		found := false
+		for _, a := range geohashAlphabet {
+			if c == a {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("invalid geohash character %q", c)
+		}
+	}
+	return nil
+}
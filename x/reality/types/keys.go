@@ -13,6 +13,20 @@ const (
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
 	GovModuleName = "gov"
+
+	// DeviceClassId is the x/nft class of the soulbound device identity tokens.
+	DeviceClassId = "contactical-device"
+
+	// RewardsAccountName is the module account holding minted epoch rewards
+	// until they are withdrawn or clawed back.
+	RewardsAccountName = ModuleName + "_rewards"
+
+	// PoolAccountName is the module account holding the swap pool reserves.
+	PoolAccountName = ModuleName + "_pool"
+
+	// EscrowAccountName is the module account holding node and relayer bonds,
+	// unbonding entries and dispute bonds.
+	EscrowAccountName = ModuleName + "_escrow"
 )
 
 // ParamsKey is the prefix to retrieve all Params
@@ -23,4 +37,34 @@ var (
 	ClaimCountKey = collections.NewPrefix("claim/count/")
 	NodeInfoKey   = collections.NewPrefix("node/info/")
 	NullifierKey  = collections.NewPrefix("node/nullifier/")
+
+	ClaimTimeIndexKey    = collections.NewPrefix("claim/time/")
+	ClaimCreatorIndexKey = collections.NewPrefix("claim/creator/")
+
+	ReputationIndexKey   = collections.NewPrefix("node/reputation/")
+	RegionIndexKey       = collections.NewPrefix("node/region/")
+	ReputationHistoryKey = collections.NewPrefix("node/history/")
+	BannedNodeKey        = collections.NewPrefix("node/banned/")
+	RetiredNodeKey       = collections.NewPrefix("node/retired/")
+
+	RewardTallyKey    = collections.NewPrefix("entropy/tally/")
+	RewardWeightKey   = collections.NewPrefix("entropy/weight/")
+	EntropyHistoryKey = collections.NewPrefix("entropy/history/")
+
+	EmissionStateKey  = collections.NewPrefix("rewards/emission/")
+	VestingTrancheKey = collections.NewPrefix("rewards/vesting/")
+
+	DisputeKey         = collections.NewPrefix("dispute/value/")
+	DisputeCountKey    = collections.NewPrefix("dispute/count/")
+	ActiveDisputeKey   = collections.NewPrefix("dispute/active/")
+	ClaimDisputeKey    = collections.NewPrefix("dispute/claim/")
+	DisputeDeadlineKey = collections.NewPrefix("dispute/deadline/")
+
+	BondKey           = collections.NewPrefix("bond/value/")
+	UnbondingKey      = collections.NewPrefix("bond/unbonding/")
+	UnbondingQueueKey = collections.NewPrefix("bond/queue/")
+
+	PoolKey       = collections.NewPrefix("pool/value/")
+	PoolCountKey  = collections.NewPrefix("pool/count/")
+	PoolDenomsKey = collections.NewPrefix("pool/denoms/")
 )
@@ -33,6 +33,9 @@ func (msg *MsgRegisterNode) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if !msg.Bond.Amount.IsNil() && !msg.Bond.IsZero() && !msg.Bond.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid bond (%s)", msg.Bond)
+	}
 	return nil
 }
 
@@ -43,3 +46,143 @@ func (msg *MsgRegisterNode) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{creator}
 }
+
+func (msg *MsgRetireNode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+func (msg *MsgWithdrawRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+func (msg *MsgBanNode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Node); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid node address (%s)", err)
+	}
+	return nil
+}
+
+func (msg *MsgChallengeClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !msg.Bond.IsValid() || !msg.Bond.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid dispute bond (%s)", msg.Bond)
+	}
+	if msg.Reason == "" {
+		return fmt.Errorf("dispute reason cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgRespondToChallenge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Payload == "" && len(msg.WitnessSignatures) == 0 {
+		return fmt.Errorf("evidence must contain a payload or witness signatures")
+	}
+	return nil
+}
+
+func (msg *MsgResolveDispute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return nil
+}
+
+func (msg *MsgRevokeNode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Node); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid node address (%s)", err)
+	}
+	return nil
+}
+
+func (msg *MsgPostBond) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid bond amount (%s)", msg.Amount)
+	}
+	return nil
+}
+
+func (msg *MsgUnbond) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid unbond amount (%s)", msg.Amount)
+	}
+	return nil
+}
+
+func (msg *MsgSwap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.ParseCoinNormalized(msg.AmountIn); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount in (%s)", err)
+	}
+	if err := sdk.ValidateDenom(msg.TargetDenom); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid target denom (%s)", err)
+	}
+	if !msg.MinAmountOut.IsNil() && msg.MinAmountOut.IsNegative() {
+		return fmt.Errorf("min amount out must be non-negative: %s", msg.MinAmountOut)
+	}
+	if len(msg.Route) > MaxRouteHops {
+		return fmt.Errorf("route has %d hops, more than %d", len(msg.Route), MaxRouteHops)
+	}
+	seen := make(map[uint64]bool)
+	for _, id := range msg.Route {
+		if seen[id] {
+			return fmt.Errorf("route repeats pool %d", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+func (msg *MsgCreatePool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !msg.TokenA.IsValid() || !msg.TokenA.IsPositive() || !msg.TokenB.IsValid() || !msg.TokenB.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid initial reserves (%s, %s)", msg.TokenA, msg.TokenB)
+	}
+	if msg.TokenA.Denom == msg.TokenB.Denom {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "pool denoms must differ (%s)", msg.TokenA.Denom)
+	}
+	return nil
+}
+
+func (msg *MsgAddLiquidity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !msg.MaxTokens.IsValid() || len(msg.MaxTokens) != 2 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "max tokens must hold both pool denoms (%s)", msg.MaxTokens)
+	}
+	return nil
+}
+
+func (msg *MsgRemoveLiquidity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Shares.IsNil() || !msg.Shares.IsPositive() {
+		return fmt.Errorf("shares must be positive")
+	}
+	if !msg.MinTokens.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid min tokens (%s)", msg.MinTokens)
+	}
+	return nil
+}
@@ -34,9 +34,11 @@ type NodeInfo struct {
 	OsPatchLevel     int32  `protobuf:"varint,8,opt,name=os_patch_level,json=osPatchLevel,proto3" json:"os_patch_level,omitempty"`
 	RegisteredAt     int64  `protobuf:"varint,9,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
 	// [수정] bytes -> string
-	PubKey    string `protobuf:"bytes,10,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	Nullifier string `protobuf:"bytes,11,opt,name=nullifier,proto3" json:"nullifier,omitempty"`
-	TrustTier int32  `protobuf:"varint,12,opt,name=trust_tier,json=trustTier,proto3" json:"trust_tier,omitempty"`
+	PubKey     string `protobuf:"bytes,10,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Nullifier  string `protobuf:"bytes,11,opt,name=nullifier,proto3" json:"nullifier,omitempty"`
+	TrustTier  int32  `protobuf:"varint,12,opt,name=trust_tier,json=trustTier,proto3" json:"trust_tier,omitempty"`
+	Reputation int64  `protobuf:"varint,13,opt,name=reputation,proto3" json:"reputation,omitempty"`
+	Region     string `protobuf:"bytes,14,opt,name=region,proto3" json:"region,omitempty"`
 }
 
 func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
@@ -156,6 +158,20 @@ func (m *NodeInfo) GetTrustTier() int32 {
 	return 0
 }
 
+func (m *NodeInfo) GetReputation() int64 {
+	if m != nil {
+		return m.Reputation
+	}
+	return 0
+}
+
+func (m *NodeInfo) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*NodeInfo)(nil), "contactical.reality.v1.NodeInfo")
 }
@@ -163,31 +179,33 @@ func init() {
 func init() { proto.RegisterFile("contactical/reality/v1/node.proto", fileDescriptor_cf075aa1a80a4bf4) }
 
 var fileDescriptor_cf075aa1a80a4bf4 = []byte{
-	// 381 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xcd, 0x6e, 0xd4, 0x30,
-	0x14, 0x85, 0xc7, 0x0c, 0x9d, 0x1f, 0x93, 0x56, 0xe0, 0x05, 0x58, 0x82, 0x46, 0xe1, 0x4f, 0x8a,
-	0x84, 0x34, 0xa3, 0x0a, 0xf1, 0x00, 0xb0, 0x43, 0x54, 0x08, 0x85, 0x8a, 0x05, 0x1b, 0xcb, 0x71,
-	0x6e, 0xc1, 0x6a, 0x9a, 0x1b, 0xd9, 0x37, 0x11, 0x79, 0x0b, 0x5e, 0x81, 0xb7, 0x61, 0xd9, 0x25,
-	0x4b, 0x34, 0xf3, 0x22, 0x95, 0x9d, 0x4e, 0x3b, 0xcb, 0x7c, 0xe7, 0xe8, 0xdc, 0x13, 0x1f, 0xfe,
-	0xdc, 0x60, 0x43, 0xda, 0x90, 0x35, 0xba, 0x5e, 0x3b, 0xd0, 0xb5, 0xa5, 0x61, 0xdd, 0x9f, 0xac,
-	0x1b, 0xac, 0x60, 0xd5, 0x3a, 0x24, 0x14, 0x8f, 0xf7, 0x2c, 0xab, 0x1b, 0xcb, 0xaa, 0x3f, 0x79,
-	0xf1, 0x67, 0xca, 0x17, 0x9f, 0xb1, 0x82, 0x8f, 0xcd, 0x39, 0x0a, 0xc9, 0xe7, 0xc6, 0x81, 0x26,
-	0x74, 0x92, 0x65, 0x2c, 0x5f, 0x16, 0xbb, 0x4f, 0xf1, 0x9a, 0x1f, 0x79, 0x30, 0x9d, 0xb3, 0x34,
-	0xa8, 0x1a, 0x7a, 0xa8, 0xe5, 0xbd, 0x8c, 0xe5, 0x07, 0xc5, 0xe1, 0x8e, 0x9e, 0x06, 0x28, 0x5e,
-	0xf2, 0xc3, 0x0a, 0x7a, 0x6b, 0x40, 0xd5, 0x68, 0x2e, 0xa0, 0x92, 0xd3, 0x8c, 0xe5, 0x8b, 0x22,
-	0x19, 0xe1, 0x69, 0x64, 0xe2, 0x98, 0xf3, 0x12, 0x91, 0x94, 0x27, 0x4d, 0x20, 0xef, 0xc7, 0x9c,
-	0x65, 0x20, 0x5f, 0x03, 0x08, 0x19, 0xf1, 0xaa, 0xc5, 0x46, 0x91, 0xbd, 0x04, 0x79, 0x90, 0xb1,
-	0x7c, 0x5a, 0x24, 0x3b, 0x78, 0x66, 0x2f, 0x41, 0xbc, 0xe1, 0x8f, 0x34, 0x11, 0x84, 0x88, 0xe0,
-	0x1b, 0x2b, 0xcd, 0x62, 0xd4, 0xc3, 0x3d, 0x61, 0x6c, 0x75, 0xcc, 0x39, 0x7a, 0xd5, 0x83, 0xf3,
-	0x16, 0x1b, 0x39, 0x1f, 0x0f, 0xa2, 0xff, 0x36, 0x02, 0xf1, 0x8a, 0x1f, 0xa1, 0x57, 0xad, 0x26,
-	0xf3, 0xf3, 0x26, 0x68, 0x11, 0x2d, 0x09, 0xfa, 0x2f, 0x01, 0xde, 0xfe, 0x9a, 0x83, 0x1f, 0xd6,
-	0x13, 0x38, 0xa8, 0x94, 0x26, 0xb9, 0x1c, 0x6b, 0xdd, 0xc1, 0xf7, 0x24, 0x9e, 0xf0, 0x79, 0xdb,
-	0x95, 0xea, 0x02, 0x06, 0xc9, 0xe3, 0x03, 0xce, 0xda, 0xae, 0xfc, 0x04, 0x83, 0x78, 0xc6, 0x97,
-	0x4d, 0x57, 0xd7, 0xf6, 0xdc, 0x82, 0x93, 0x0f, 0xa2, 0x74, 0x07, 0x42, 0x41, 0x72, 0x9d, 0x27,
-	0x45, 0x41, 0x4e, 0xc6, 0x82, 0x91, 0x9c, 0x59, 0x70, 0x1f, 0xde, 0xfd, 0xdd, 0xa4, 0xec, 0x6a,
-	0x93, 0xb2, 0xff, 0x9b, 0x94, 0xfd, 0xde, 0xa6, 0x93, 0xab, 0x6d, 0x3a, 0xf9, 0xb7, 0x4d, 0x27,
-	0xdf, 0x9f, 0xee, 0x0f, 0xff, 0xeb, 0x76, 0x7a, 0x1a, 0x5a, 0xf0, 0xe5, 0x2c, 0x2e, 0xff, 0xf6,
-	0x3a, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x06, 0x94, 0x98, 0x1e, 0x02, 0x00, 0x00,
+	// 403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0xcf, 0x8a, 0x13, 0x41,
+	0x10, 0xc6, 0x33, 0xc6, 0xcd, 0x9f, 0x32, 0x09, 0xda, 0x87, 0xb5, 0x41, 0x77, 0x88, 0xff, 0x20,
+	0x20, 0x24, 0x2c, 0xe2, 0x03, 0xe8, 0x4d, 0x5c, 0x44, 0xe2, 0xe2, 0xc1, 0x4b, 0xd3, 0xe9, 0xa9,
+	0xd5, 0x66, 0x67, 0xa7, 0x86, 0xee, 0x9a, 0xc1, 0x79, 0x0b, 0x1f, 0xcb, 0xe3, 0x1e, 0xf5, 0x26,
+	0xc9, 0x8b, 0x48, 0x77, 0x6f, 0x76, 0x73, 0xec, 0x5f, 0x7d, 0x7c, 0xdf, 0x57, 0x4d, 0xc1, 0x33,
+	0x43, 0x15, 0x6b, 0xc3, 0xd6, 0xe8, 0x72, 0xe5, 0x50, 0x97, 0x96, 0xbb, 0x55, 0x7b, 0xba, 0xaa,
+	0xa8, 0xc0, 0x65, 0xed, 0x88, 0x49, 0x1c, 0x1f, 0x48, 0x96, 0x37, 0x92, 0x65, 0x7b, 0xfa, 0xfc,
+	0x6f, 0x1f, 0x46, 0x9f, 0xa8, 0xc0, 0x0f, 0xd5, 0x05, 0x09, 0x09, 0x43, 0xe3, 0x50, 0x33, 0x39,
+	0x99, 0xcd, 0xb3, 0xc5, 0x78, 0xbd, 0x7f, 0x8a, 0x57, 0x30, 0xf3, 0x68, 0x1a, 0x67, 0xb9, 0x53,
+	0x25, 0xb6, 0x58, 0xca, 0x7b, 0xf3, 0x6c, 0x71, 0xb4, 0x9e, 0xee, 0xe9, 0x59, 0x80, 0xe2, 0x05,
+	0x4c, 0x0b, 0x6c, 0xad, 0x41, 0x55, 0x92, 0xb9, 0xc4, 0x42, 0xf6, 0xe7, 0xd9, 0x62, 0xb4, 0x9e,
+	0x24, 0x78, 0x16, 0x99, 0x38, 0x01, 0xd8, 0x10, 0xb1, 0xf2, 0xac, 0x19, 0xe5, 0xfd, 0xe8, 0x33,
+	0x0e, 0xe4, 0x4b, 0x00, 0xc1, 0x23, 0xa6, 0x5a, 0xaa, 0x14, 0xdb, 0x2b, 0x94, 0x47, 0xf3, 0x6c,
+	0xd1, 0x5f, 0x4f, 0xf6, 0xf0, 0xdc, 0x5e, 0xa1, 0x78, 0x0d, 0x8f, 0x34, 0x33, 0x06, 0x8b, 0xa0,
+	0x4b, 0x95, 0x06, 0xd1, 0xea, 0xe1, 0xc1, 0x20, 0xb5, 0x3a, 0x01, 0x20, 0xaf, 0x5a, 0x74, 0xde,
+	0x52, 0x25, 0x87, 0x29, 0x90, 0xfc, 0xd7, 0x04, 0xc4, 0x4b, 0x98, 0x91, 0x57, 0xb5, 0x66, 0xf3,
+	0xe3, 0xc6, 0x68, 0x14, 0x25, 0x13, 0xf2, 0x9f, 0x03, 0xbc, 0x5d, 0xcd, 0xe1, 0x77, 0xeb, 0x19,
+	0x1d, 0x16, 0x4a, 0xb3, 0x1c, 0xa7, 0x5a, 0x77, 0xf0, 0x1d, 0x8b, 0xc7, 0x30, 0xac, 0x9b, 0x8d,
+	0xba, 0xc4, 0x4e, 0x42, 0xfc, 0xc0, 0x41, 0xdd, 0x6c, 0x3e, 0x62, 0x27, 0x9e, 0xc2, 0xb8, 0x6a,
+	0xca, 0xd2, 0x5e, 0x58, 0x74, 0xf2, 0x41, 0x1c, 0xdd, 0x81, 0x50, 0x90, 0x5d, 0xe3, 0x59, 0x71,
+	0x18, 0x4f, 0x52, 0xc1, 0x48, 0xce, 0xc3, 0x38, 0x07, 0x70, 0x58, 0x37, 0x69, 0x25, 0x39, 0x8d,
+	0xb9, 0x07, 0x44, 0x1c, 0xc3, 0x20, 0xb4, 0xa0, 0x4a, 0xce, 0x52, 0x68, 0x7a, 0xbd, 0x7f, 0xfb,
+	0x7b, 0x9b, 0x67, 0xd7, 0xdb, 0x3c, 0xfb, 0xb7, 0xcd, 0xb3, 0x5f, 0xbb, 0xbc, 0x77, 0xbd, 0xcb,
+	0x7b, 0x7f, 0x76, 0x79, 0xef, 0xdb, 0x93, 0xc3, 0x83, 0xf9, 0x79, 0x7b, 0x32, 0xdc, 0xd5, 0xe8,
+	0x37, 0x83, 0x78, 0x31, 0x6f, 0xfe, 0x07, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x44, 0xc4, 0x76, 0x56,
+	0x02, 0x00, 0x00,
 }
 
 func (m *NodeInfo) Marshal() (dAtA []byte, err error) {
@@ -210,6 +228,18 @@ func (m *NodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Reputation != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.Reputation))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.TrustTier != 0 {
 		i = encodeVarintNode(dAtA, i, uint64(m.TrustTier))
 		i--
@@ -340,6 +370,13 @@ func (m *NodeInfo) Size() (n int) {
 	if m.TrustTier != 0 {
 		n += 1 + sovNode(uint64(m.TrustTier))
 	}
+	if m.Reputation != 0 {
+		n += 1 + sovNode(uint64(m.Reputation))
+	}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
 	return n
 }
 
@@ -646,6 +683,57 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
+			}
+			m.Reputation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reputation |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNode(dAtA[iNdEx:])
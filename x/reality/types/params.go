@@ -1,6 +1,14 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultRewardDenom is the denom of the default epoch emission.
+const DefaultRewardDenom = "stake"
 
 // NewParams creates a new Params instance with default values.
 func NewParams() Params {
@@ -14,9 +22,41 @@ func NewParams() Params {
 			"boot_lock":        10,
 			"density_per_node": 20,
 		},
+		EpochIdentifier:          "day",
+		RegionGeohashPrecision:   5,
+		EntropyTarget:            math.LegacyNewDecWithPrec(8, 1),
+		MaxRewardBoost:           math.LegacyNewDec(2),
+		EpochEmission:            sdk.NewInt64Coin(DefaultRewardDenom, 100_000_000),
+		HalvingInterval:          365,
+		MaxTotalEmission:         math.NewInt(50_000_000_000),
+		VestingPeriod:            30 * 24 * 60 * 60,
+		DisputeMinBond:           sdk.NewInt64Coin(DefaultRewardDenom, 1_000_000),
+		DisputeResponseWindow:    3 * 24 * 60 * 60,
+		DisputeResolutionWindow:  7 * 24 * 60 * 60,
+		DisputeSlashFraction:     math.LegacyNewDecWithPrec(5, 1),
+		DisputeReputationPenalty: 100,
+		TierBonds: []TierBond{
+			{TrustTier: 1, MinBond: sdk.NewInt64Coin(BondDenom, 10_000_000)},
+			{TrustTier: 2, MinBond: sdk.NewInt64Coin(BondDenom, 1_000_000)},
+		},
+		UnbondingPeriod:     21 * 24 * 60 * 60,
+		BondSlashDispute:    math.LegacyNewDecWithPrec(1, 1),
+		BondSlashRevocation: math.LegacyNewDecWithPrec(5, 1),
+		BondSlashBan:        math.LegacyOneDec(),
+		SwapFee:             math.LegacyNewDecWithPrec(3, 3),
 	}
 }
 
+// MinBond returns the minimum bond of a trust tier and whether one is required.
+func (p Params) MinBond(trustTier int32) (sdk.Coin, bool) {
+	for _, tb := range p.TierBonds {
+		if tb.TrustTier == trustTier {
+			return tb.MinBond, true
+		}
+	}
+	return sdk.Coin{}, false
+}
+
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return NewParams()
@@ -46,5 +86,73 @@ func (p Params) Validate() error {
 		}
 	}
 
+	if p.EpochIdentifier == "" {
+		return fmt.Errorf("epoch identifier cannot be empty")
+	}
+	if p.RegionGeohashPrecision == 0 || p.RegionGeohashPrecision > MaxGeohashPrecision {
+		return fmt.Errorf("region geohash precision must be between 1 and %d: %d", MaxGeohashPrecision, p.RegionGeohashPrecision)
+	}
+
+	if p.EntropyTarget.IsNil() || p.EntropyTarget.IsNegative() || p.EntropyTarget.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("entropy target must be between 0 and 1: %s", p.EntropyTarget)
+	}
+	if p.MaxRewardBoost.IsNil() || p.MaxRewardBoost.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("max reward boost must be at least 1: %s", p.MaxRewardBoost)
+	}
+	if err := p.EpochEmission.Validate(); err != nil {
+		return fmt.Errorf("invalid epoch emission: %w", err)
+	}
+	if p.MaxTotalEmission.IsNil() || p.MaxTotalEmission.IsNegative() {
+		return fmt.Errorf("max total emission must be non-negative: %s", p.MaxTotalEmission)
+	}
+
+	if err := p.DisputeMinBond.Validate(); err != nil {
+		return fmt.Errorf("invalid dispute min bond: %w", err)
+	}
+	if p.DisputeResponseWindow == 0 {
+		return fmt.Errorf("dispute response window must be positive")
+	}
+	if p.DisputeResolutionWindow == 0 {
+		return fmt.Errorf("dispute resolution window must be positive")
+	}
+	if p.DisputeJury != "" {
+		if _, err := sdk.AccAddressFromBech32(p.DisputeJury); err != nil {
+			return fmt.Errorf("invalid dispute jury address: %w", err)
+		}
+	}
+	if p.DisputeSlashFraction.IsNil() || p.DisputeSlashFraction.IsNegative() || p.DisputeSlashFraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("dispute slash fraction must be between 0 and 1: %s", p.DisputeSlashFraction)
+	}
+	if p.DisputeReputationPenalty < 0 {
+		return fmt.Errorf("dispute reputation penalty must be non-negative: %d", p.DisputeReputationPenalty)
+	}
+
+	tiers := make(map[int32]bool)
+	for _, tb := range p.TierBonds {
+		if tiers[tb.TrustTier] {
+			return fmt.Errorf("duplicated min bond for trust tier %d", tb.TrustTier)
+		}
+		if err := tb.MinBond.Validate(); err != nil {
+			return fmt.Errorf("invalid min bond for trust tier %d: %w", tb.TrustTier, err)
+		}
+		if tb.MinBond.Denom != BondDenom {
+			return fmt.Errorf("min bond for trust tier %d must be in %s: %s", tb.TrustTier, BondDenom, tb.MinBond)
+		}
+		tiers[tb.TrustTier] = true
+	}
+	for name, fraction := range map[string]math.LegacyDec{
+		"dispute":    p.BondSlashDispute,
+		"revocation": p.BondSlashRevocation,
+		"ban":        p.BondSlashBan,
+	} {
+		if fraction.IsNil() || fraction.IsNegative() || fraction.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("bond slash fraction for %s must be between 0 and 1: %s", name, fraction)
+		}
+	}
+
+	if p.SwapFee.IsNil() || p.SwapFee.IsNegative() || p.SwapFee.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("swap fee must be in [0, 1): %s", p.SwapFee)
+	}
+
 	return nil
-}
\ No newline at end of file
+}
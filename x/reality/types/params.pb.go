@@ -4,10 +4,14 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_sortkeys "github.com/cosmos/gogoproto/sortkeys"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -35,6 +39,46 @@ type Params struct {
 	// [신규] 보안 요소별 가중치 (기존 개별 필드 대체, 확장성 확보)
 	// 예: "strongbox": 50, "tee": 30, "boot_lock": 10, "density": 20
 	SecurityWeights map[string]int32 `protobuf:"bytes,4,rep,name=security_weights,json=securityWeights,proto3" json:"security_weights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// 평판 체크포인트를 기록할 x/epochs 식별자 (예: "day")
+	EpochIdentifier string `protobuf:"bytes,5,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// 지역 리더보드에 사용하는 geohash 길이
+	RegionGeohashPrecision uint32 `protobuf:"varint,6,opt,name=region_geohash_precision,json=regionGeohashPrecision,proto3" json:"region_geohash_precision,omitempty"`
+	// 탈중앙화 목표 엔트로피 (0~1, 정규화된 Shannon entropy). 미달 시 보상 가중치 자동 조정
+	EntropyTarget cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=entropy_target,json=entropyTarget,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"entropy_target"`
+	// 적응형 가중치의 최대 배율 (최소 배율은 1 / max_reward_boost)
+	MaxRewardBoost cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=max_reward_boost,json=maxRewardBoost,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_reward_boost"`
+	// epoch 마다 claim 포인트 비율로 분배되는 초기 보상 예산 (반감기마다 절반)
+	EpochEmission types.Coin `protobuf:"bytes,9,opt,name=epoch_emission,json=epochEmission,proto3" json:"epoch_emission"`
+	// 보상 예산이 절반으로 줄어드는 epoch 간격 (0이면 반감 없음)
+	HalvingInterval uint64 `protobuf:"varint,10,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty"`
+	// 모듈이 발행할 수 있는 누적 보상 총량 상한 (hard cap)
+	MaxTotalEmission cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=max_total_emission,json=maxTotalEmission,proto3,customtype=cosmossdk.io/math.Int" json:"max_total_emission"`
+	// 분배된 보상이 선형으로 베스팅되는 기간 (초, 0이면 즉시 인출 가능)
+	VestingPeriod uint64 `protobuf:"varint,12,opt,name=vesting_period,json=vestingPeriod,proto3" json:"vesting_period,omitempty"`
+	// claim 이의제기에 필요한 최소 보증금
+	DisputeMinBond types.Coin `protobuf:"bytes,13,opt,name=dispute_min_bond,json=disputeMinBond,proto3" json:"dispute_min_bond"`
+	// 노드가 추가 증거를 제출할 수 있는 기간 (초)
+	DisputeResponseWindow uint64 `protobuf:"varint,14,opt,name=dispute_response_window,json=disputeResponseWindow,proto3" json:"dispute_response_window,omitempty"`
+	// 증거 제출 후 거버넌스/배심원이 판정할 수 있는 기간 (초)
+	DisputeResolutionWindow uint64 `protobuf:"varint,15,opt,name=dispute_resolution_window,json=disputeResolutionWindow,proto3" json:"dispute_resolution_window,omitempty"`
+	// 분쟁을 판정할 수 있는 group policy 주소 (비어 있으면 거버넌스만 판정)
+	DisputeJury string `protobuf:"bytes,16,opt,name=dispute_jury,json=disputeJury,proto3" json:"dispute_jury,omitempty"`
+	// 이의제기가 기각되었을 때 소각되는 보증금 비율
+	DisputeSlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,17,opt,name=dispute_slash_fraction,json=disputeSlashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"dispute_slash_fraction"`
+	// 이의제기가 인용되었을 때 노드에서 차감되는 평판
+	DisputeReputationPenalty int64 `protobuf:"varint,18,opt,name=dispute_reputation_penalty,json=disputeReputationPenalty,proto3" json:"dispute_reputation_penalty,omitempty"`
+	// 신뢰 등급별 노드 등록 최소 보증금 (없는 등급은 보증금 불필요)
+	TierBonds []TierBond `protobuf:"bytes,19,rep,name=tier_bonds,json=tierBonds,proto3" json:"tier_bonds"`
+	// 노드 은퇴/보증금 인출 후 반환까지의 대기 기간 (초)
+	UnbondingPeriod uint64 `protobuf:"varint,20,opt,name=unbonding_period,json=unbondingPeriod,proto3" json:"unbonding_period,omitempty"`
+	// 이의제기가 인용되었을 때 노드/릴레이어 보증금의 소각 비율
+	BondSlashDispute cosmossdk_io_math.LegacyDec `protobuf:"bytes,21,opt,name=bond_slash_dispute,json=bondSlashDispute,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bond_slash_dispute"`
+	// 노드 인증이 취소되었을 때 보증금의 소각 비율
+	BondSlashRevocation cosmossdk_io_math.LegacyDec `protobuf:"bytes,22,opt,name=bond_slash_revocation,json=bondSlashRevocation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bond_slash_revocation"`
+	// 노드가 차단되었을 때 보증금의 소각 비율
+	BondSlashBan cosmossdk_io_math.LegacyDec `protobuf:"bytes,23,opt,name=bond_slash_ban,json=bondSlashBan,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bond_slash_ban"`
+	// 스왑 수수료 비율 (입력 토큰에서 차감되어 풀에 남음)
+	SwapFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,24,opt,name=swap_fee,json=swapFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"swap_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -47,16 +91,12 @@ func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
+	return b[:n], nil
 }
 func (m *Params) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_Params.Merge(m, src)
@@ -98,6 +138,90 @@ func (m *Params) GetSecurityWeights() map[string]int32 {
 	return nil
 }
 
+func (m *Params) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetRegionGeohashPrecision() uint32 {
+	if m != nil {
+		return m.RegionGeohashPrecision
+	}
+	return 0
+}
+
+func (m *Params) GetEpochEmission() types.Coin {
+	if m != nil {
+		return m.EpochEmission
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetHalvingInterval() uint64 {
+	if m != nil {
+		return m.HalvingInterval
+	}
+	return 0
+}
+
+func (m *Params) GetVestingPeriod() uint64 {
+	if m != nil {
+		return m.VestingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetDisputeMinBond() types.Coin {
+	if m != nil {
+		return m.DisputeMinBond
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetDisputeResponseWindow() uint64 {
+	if m != nil {
+		return m.DisputeResponseWindow
+	}
+	return 0
+}
+
+func (m *Params) GetDisputeResolutionWindow() uint64 {
+	if m != nil {
+		return m.DisputeResolutionWindow
+	}
+	return 0
+}
+
+func (m *Params) GetDisputeJury() string {
+	if m != nil {
+		return m.DisputeJury
+	}
+	return ""
+}
+
+func (m *Params) GetDisputeReputationPenalty() int64 {
+	if m != nil {
+		return m.DisputeReputationPenalty
+	}
+	return 0
+}
+
+func (m *Params) GetTierBonds() []TierBond {
+	if m != nil {
+		return m.TierBonds
+	}
+	return nil
+}
+
+func (m *Params) GetUnbondingPeriod() uint64 {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "contactical.reality.v1.Params")
 	proto.RegisterMapType((map[string]int32)(nil), "contactical.reality.v1.Params.SecurityWeightsEntry")
@@ -108,29 +232,67 @@ func init() {
 }
 
 var fileDescriptor_6500cda98d68c26f = []byte{
-	// 354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0x4b, 0x32, 0x41,
-	0x1c, 0xc6, 0x5d, 0xf7, 0x55, 0x78, 0x27, 0x4a, 0x9d, 0x24, 0x16, 0x8b, 0x4d, 0x8a, 0x42, 0x3a,
-	0xcc, 0x62, 0x12, 0x84, 0x47, 0xa1, 0x7b, 0xac, 0x46, 0xd0, 0xa1, 0x65, 0x5c, 0x07, 0x1d, 0xda,
-	0x9d, 0x91, 0x99, 0x59, 0x75, 0xbf, 0x42, 0xa7, 0x3e, 0x42, 0x1f, 0xa1, 0x8f, 0xd1, 0xd1, 0x4b,
-	0xd0, 0x31, 0xf4, 0x50, 0x1f, 0x23, 0x76, 0xc6, 0xc2, 0xc0, 0xcb, 0xf0, 0x9f, 0x67, 0x7e, 0xcf,
-	0xf0, 0x7f, 0x78, 0xc0, 0x71, 0xc8, 0x99, 0xc2, 0xa1, 0xa2, 0x21, 0x8e, 0x3c, 0x41, 0x70, 0x44,
-	0x55, 0xea, 0x4d, 0x9a, 0xde, 0x18, 0x0b, 0x1c, 0x4b, 0x34, 0x16, 0x5c, 0x71, 0xb8, 0xb7, 0x06,
-	0xa1, 0x15, 0x84, 0x26, 0xcd, 0x5a, 0x05, 0xc7, 0x94, 0x71, 0x4f, 0x9f, 0x06, 0xad, 0x55, 0x87,
-	0x7c, 0xc8, 0xf5, 0xe8, 0x65, 0x93, 0x51, 0x8f, 0xde, 0xf2, 0xa0, 0x78, 0xad, 0x7f, 0x84, 0x0d,
-	0x50, 0x16, 0x64, 0x8a, 0xc5, 0x20, 0xe8, 0x63, 0x49, 0x82, 0x84, 0x51, 0xe5, 0x58, 0x75, 0xab,
-	0x61, 0xfb, 0x3b, 0x46, 0xef, 0x60, 0x49, 0x6e, 0x18, 0x55, 0xf0, 0x14, 0x94, 0x62, 0x3c, 0x0b,
-	0x94, 0x48, 0xa4, 0x0a, 0x64, 0xc8, 0x05, 0x71, 0xf2, 0x1a, 0xdc, 0x8e, 0xf1, 0xac, 0x97, 0xa9,
-	0xdd, 0x4c, 0x84, 0x08, 0xec, 0xc6, 0x94, 0x19, 0x22, 0x50, 0x23, 0x41, 0xe4, 0x88, 0x47, 0x03,
-	0xc7, 0xd6, 0x6c, 0x25, 0xa6, 0x4c, 0x63, 0xbd, 0x9f, 0x07, 0x78, 0x0f, 0xca, 0x92, 0x84, 0x89,
-	0xa0, 0x2a, 0x0d, 0xa6, 0x84, 0x0e, 0x47, 0x4a, 0x3a, 0xff, 0xea, 0x76, 0x63, 0xeb, 0xbc, 0x85,
-	0x36, 0x07, 0x45, 0x66, 0x77, 0xd4, 0x5d, 0xd9, 0x6e, 0x8d, 0xeb, 0x8a, 0x29, 0x91, 0xfa, 0x25,
-	0xf9, 0x57, 0xad, 0x75, 0x40, 0x75, 0x13, 0x08, 0xcb, 0xc0, 0x7e, 0x20, 0xa9, 0x0e, 0xfb, 0xdf,
-	0xcf, 0x46, 0x58, 0x05, 0x85, 0x09, 0x8e, 0x12, 0x93, 0xab, 0xe0, 0x9b, 0x4b, 0x3b, 0x7f, 0x69,
-	0xb5, 0x4f, 0xbe, 0x9e, 0x0f, 0xad, 0xc7, 0xcf, 0x97, 0xb3, 0x83, 0xf5, 0x7e, 0x66, 0xbf, 0x0d,
-	0x99, 0x85, 0x3a, 0x17, 0xaf, 0x0b, 0xd7, 0x9a, 0x2f, 0x5c, 0xeb, 0x63, 0xe1, 0x5a, 0x4f, 0x4b,
-	0x37, 0x37, 0x5f, 0xba, 0xb9, 0xf7, 0xa5, 0x9b, 0xbb, 0xdb, 0xdf, 0xec, 0x53, 0xe9, 0x98, 0xc8,
-	0x7e, 0x51, 0xb7, 0xd2, 0xfa, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xd8, 0xf7, 0xf7, 0xe1, 0xfd, 0x01,
+	// 962 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0x36, 0x4d, 0xda, 0x4c, 0x62, 0xc7, 0x99, 0x38, 0xc9, 0x24, 0x45, 0x8e, 0x01, 0x81,
+	0xdc, 0xa2, 0xae, 0xe5, 0x56, 0xa0, 0x2a, 0x70, 0xc1, 0x24, 0x45, 0x81, 0x82, 0xa2, 0x8d, 0x51,
+	0xf8, 0x90, 0x58, 0x8d, 0x77, 0x27, 0xeb, 0xa1, 0xbb, 0x33, 0xab, 0x99, 0x59, 0x3b, 0xfb, 0x17,
+	0x38, 0x71, 0xe4, 0x08, 0x37, 0x8e, 0x3d, 0xf4, 0x47, 0xf4, 0x58, 0xf5, 0x84, 0x38, 0x54, 0x28,
+	0x39, 0x94, 0x9f, 0xc0, 0x11, 0xcd, 0x87, 0x1d, 0x23, 0xd2, 0x03, 0xbe, 0x58, 0x9e, 0xe7, 0x7d,
+	0x9f, 0xe7, 0xfd, 0x98, 0x77, 0xe7, 0x05, 0x6f, 0x47, 0x9c, 0x29, 0x1c, 0x29, 0x1a, 0xe1, 0xb4,
+	0x2d, 0x08, 0x4e, 0xa9, 0x2a, 0xdb, 0xc3, 0x4e, 0x3b, 0xc7, 0x02, 0x67, 0xd2, 0xcf, 0x05, 0x57,
+	0x1c, 0x6e, 0x4e, 0x39, 0xf9, 0xce, 0xc9, 0x1f, 0x76, 0x76, 0xd6, 0x70, 0x46, 0x19, 0x6f, 0x9b,
+	0x5f, 0xeb, 0xba, 0xf3, 0xe6, 0x6b, 0xf4, 0xfa, 0x9c, 0xc5, 0xce, 0xa5, 0x11, 0x71, 0x99, 0x71,
+	0xd9, 0xee, 0x63, 0x49, 0xda, 0xc3, 0x4e, 0x9f, 0x28, 0xdc, 0x69, 0x47, 0x9c, 0x32, 0x67, 0xdf,
+	0xb6, 0xf6, 0xd0, 0x9c, 0xda, 0xf6, 0xe0, 0x4c, 0xf5, 0x84, 0x27, 0xdc, 0xe2, 0xfa, 0x9f, 0x45,
+	0xdf, 0xfa, 0xbb, 0x02, 0x16, 0x8f, 0x4c, 0xbe, 0xb0, 0x05, 0x6a, 0x82, 0x8c, 0xb0, 0x88, 0x43,
+	0xad, 0x1e, 0x16, 0x8c, 0x2a, 0xe4, 0x35, 0xbd, 0xd6, 0x7c, 0x50, 0xb5, 0x78, 0x17, 0x4b, 0xf2,
+	0x15, 0xa3, 0x0a, 0xbe, 0x0b, 0x56, 0x33, 0x7c, 0x16, 0x2a, 0x51, 0x48, 0x15, 0xca, 0x88, 0x0b,
+	0x82, 0xae, 0x19, 0xc7, 0x4a, 0x86, 0xcf, 0x7a, 0x1a, 0x3d, 0xd6, 0x20, 0xf4, 0xc1, 0x7a, 0x46,
+	0x99, 0xf5, 0x08, 0xd5, 0x40, 0x10, 0x39, 0xe0, 0x69, 0x8c, 0xe6, 0x8d, 0xef, 0x5a, 0x46, 0x99,
+	0x71, 0xeb, 0x8d, 0x0d, 0xf0, 0x7b, 0x50, 0x93, 0x24, 0x2a, 0x04, 0x55, 0x65, 0x38, 0x22, 0x34,
+	0x19, 0x28, 0x89, 0xae, 0x37, 0xe7, 0x5b, 0xcb, 0xf7, 0xee, 0xfb, 0x57, 0xb7, 0xd1, 0xb7, 0xb9,
+	0xfb, 0xc7, 0x8e, 0x76, 0x62, 0x59, 0x07, 0x4c, 0x89, 0x32, 0x58, 0x95, 0xff, 0x46, 0xe1, 0x6d,
+	0x50, 0x23, 0x39, 0x8f, 0x06, 0x21, 0x8d, 0x09, 0x53, 0xf4, 0x94, 0x12, 0x81, 0x16, 0x9a, 0x5e,
+	0x6b, 0x29, 0x58, 0x35, 0xf8, 0xe1, 0x04, 0x86, 0x0f, 0x00, 0x12, 0x24, 0xa1, 0x9c, 0x85, 0x09,
+	0xe1, 0x03, 0x2c, 0x07, 0x61, 0x2e, 0x48, 0x44, 0x25, 0xe5, 0x0c, 0x2d, 0x36, 0xbd, 0x56, 0x25,
+	0xd8, 0xb4, 0xf6, 0x4f, 0xad, 0xf9, 0x68, 0x6c, 0x85, 0x5f, 0x83, 0x2a, 0x61, 0x4a, 0xf0, 0xbc,
+	0x0c, 0x15, 0x16, 0x09, 0x51, 0xe8, 0x86, 0x0e, 0xd1, 0xed, 0x3c, 0x7b, 0xb9, 0x3b, 0xf7, 0xc7,
+	0xcb, 0xdd, 0x5b, 0xf6, 0x56, 0x64, 0xfc, 0xd8, 0xa7, 0xbc, 0x9d, 0x61, 0x35, 0xf0, 0x1f, 0x91,
+	0x04, 0x47, 0xe5, 0x3e, 0x89, 0x5e, 0x3c, 0xbd, 0x0b, 0xdc, 0xa5, 0xed, 0x93, 0x28, 0xa8, 0x38,
+	0xa1, 0x9e, 0xd1, 0x81, 0xdf, 0x81, 0x9a, 0x6e, 0xfb, 0xf8, 0x92, 0x38, 0x97, 0x0a, 0xdd, 0x9c,
+	0x55, 0xbb, 0x9a, 0xe1, 0xb3, 0xc0, 0x5e, 0xab, 0x16, 0x82, 0x9f, 0x83, 0xaa, 0xed, 0x0d, 0xc9,
+	0xa8, 0x34, 0x65, 0x2e, 0x35, 0xbd, 0xd6, 0xf2, 0xbd, 0x6d, 0xdf, 0x91, 0xf4, 0x50, 0xf8, 0x6e,
+	0xe4, 0xfc, 0x4f, 0x38, 0x65, 0xdd, 0x25, 0x1d, 0xf5, 0xb7, 0x57, 0x4f, 0xee, 0x78, 0x41, 0xc5,
+	0x70, 0x0f, 0x1c, 0x55, 0x37, 0x7a, 0x80, 0xd3, 0x21, 0x65, 0x49, 0x48, 0x99, 0x22, 0x62, 0x88,
+	0x53, 0x04, 0x9a, 0x5e, 0xeb, 0x7a, 0xb0, 0xea, 0xf0, 0x43, 0x07, 0xc3, 0x6f, 0x00, 0x34, 0xb3,
+	0xc4, 0x15, 0x4e, 0x2f, 0x63, 0x2f, 0x9b, 0xb2, 0xde, 0x73, 0x65, 0x6d, 0xfc, 0xb7, 0xac, 0x43,
+	0xa6, 0xa6, 0x0a, 0x3a, 0x64, 0x2a, 0xd0, 0xbd, 0xe9, 0x69, 0x95, 0x49, 0x16, 0xef, 0x80, 0xea,
+	0x90, 0x48, 0xa5, 0xb3, 0xc8, 0x89, 0xa0, 0x3c, 0x46, 0x2b, 0x26, 0x87, 0x8a, 0x43, 0x8f, 0x0c,
+	0x08, 0xbf, 0x04, 0xb5, 0x98, 0xca, 0xbc, 0x50, 0x24, 0xd4, 0xd3, 0xaa, 0xbf, 0x36, 0x54, 0xf9,
+	0x1f, 0xb5, 0x57, 0x1d, 0xfb, 0x0b, 0xca, 0xba, 0x9c, 0xc5, 0xf0, 0x03, 0xb0, 0x35, 0xd6, 0x13,
+	0x44, 0xe6, 0x9c, 0x49, 0x12, 0x8e, 0x28, 0x8b, 0xf9, 0x08, 0x55, 0x4d, 0xfc, 0x0d, 0x67, 0x0e,
+	0x9c, 0xf5, 0xc4, 0x18, 0xe1, 0x1e, 0xd8, 0x9e, 0xe2, 0xf1, 0xb4, 0x50, 0x7a, 0xfc, 0x1c, 0x73,
+	0xd5, 0x30, 0xb7, 0x2e, 0x99, 0xce, 0xee, 0xb8, 0x1f, 0x82, 0x95, 0x31, 0xf7, 0x87, 0x42, 0x94,
+	0xa8, 0x66, 0xfa, 0x87, 0x5e, 0x3c, 0xbd, 0x5b, 0x77, 0x25, 0x7c, 0x1c, 0xc7, 0x82, 0x48, 0x79,
+	0xac, 0x04, 0x65, 0x49, 0xb0, 0xec, 0xbc, 0x3f, 0x2b, 0x44, 0x09, 0x13, 0xb0, 0x39, 0x26, 0xcb,
+	0x54, 0x8f, 0xfa, 0xa9, 0xd0, 0x5f, 0x1a, 0x67, 0x68, 0x6d, 0xd6, 0xe9, 0xaa, 0x3b, 0xc1, 0x63,
+	0xad, 0xf7, 0xd0, 0xc9, 0xc1, 0x8f, 0xc0, 0xce, 0x65, 0x85, 0x79, 0xa1, 0xb0, 0xa9, 0x30, 0x27,
+	0x0c, 0xa7, 0xaa, 0x44, 0xd0, 0x3c, 0x0b, 0x68, 0x52, 0xe2, 0xd8, 0xe1, 0xc8, 0xda, 0xe1, 0x01,
+	0x00, 0x8a, 0x12, 0x61, 0x2e, 0x48, 0xa2, 0x75, 0xf3, 0x2e, 0x34, 0x5f, 0xf7, 0x2e, 0xf4, 0x28,
+	0x11, 0xfa, 0x36, 0xba, 0xd7, 0x75, 0xf2, 0xc1, 0x92, 0x72, 0x67, 0xf3, 0x08, 0x14, 0x4c, 0x6b,
+	0x4c, 0xcd, 0x45, 0xdd, 0xce, 0xe6, 0x04, 0x77, 0x93, 0x11, 0x02, 0xa8, 0x01, 0xd7, 0x15, 0x97,
+	0x18, 0xda, 0x98, 0xb5, 0x29, 0x35, 0x2d, 0x66, 0x3a, 0xb2, 0x6f, 0xa5, 0x20, 0x01, 0x1b, 0x53,
+	0x01, 0x04, 0x19, 0xf2, 0xc8, 0x94, 0x8c, 0x36, 0x67, 0x8d, 0xb1, 0x3e, 0x89, 0x11, 0x4c, 0xd4,
+	0xe0, 0x09, 0xa8, 0x4e, 0x85, 0xe9, 0x63, 0x86, 0xb6, 0x66, 0xd5, 0x5f, 0x99, 0xe8, 0x77, 0x31,
+	0x83, 0x8f, 0xc0, 0x4d, 0x39, 0xc2, 0x79, 0x78, 0x4a, 0x08, 0x42, 0xb3, 0x4a, 0xde, 0xd0, 0x12,
+	0x0f, 0x09, 0xd9, 0xe9, 0x82, 0xfa, 0x55, 0xef, 0x38, 0xac, 0x81, 0xf9, 0xc7, 0xa4, 0x34, 0xbb,
+	0x68, 0x29, 0xd0, 0x7f, 0x61, 0x1d, 0x2c, 0x0c, 0x71, 0x5a, 0xd8, 0xb5, 0xb3, 0x10, 0xd8, 0xc3,
+	0xde, 0xb5, 0x07, 0xde, 0xde, 0xed, 0xbf, 0x7e, 0xd9, 0xf5, 0x7e, 0xfe, 0x75, 0xd7, 0xfb, 0xf1,
+	0xd5, 0x93, 0x3b, 0x6f, 0x4c, 0x2f, 0xd4, 0xb3, 0xc9, 0x4a, 0xb5, 0x3b, 0xa3, 0xfb, 0xfe, 0xb3,
+	0xf3, 0x86, 0xf7, 0xfc, 0xbc, 0xe1, 0xfd, 0x79, 0xde, 0xf0, 0x7e, 0xba, 0x68, 0xcc, 0x3d, 0xbf,
+	0x68, 0xcc, 0xfd, 0x7e, 0xd1, 0x98, 0xfb, 0xf6, 0xd6, 0xd5, 0x3c, 0x55, 0xe6, 0x44, 0xf6, 0x17,
+	0xcd, 0xe2, 0xbc, 0xff, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x5e, 0xfe, 0xe2, 0xfe, 0x07,
 	0x00, 0x00,
 }
 
@@ -170,6 +332,71 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.EpochIdentifier != that1.EpochIdentifier {
+		return false
+	}
+	if this.RegionGeohashPrecision != that1.RegionGeohashPrecision {
+		return false
+	}
+	if !this.EntropyTarget.Equal(that1.EntropyTarget) {
+		return false
+	}
+	if !this.MaxRewardBoost.Equal(that1.MaxRewardBoost) {
+		return false
+	}
+	if !this.EpochEmission.Equal(&that1.EpochEmission) {
+		return false
+	}
+	if this.HalvingInterval != that1.HalvingInterval {
+		return false
+	}
+	if !this.MaxTotalEmission.Equal(that1.MaxTotalEmission) {
+		return false
+	}
+	if this.VestingPeriod != that1.VestingPeriod {
+		return false
+	}
+	if !this.DisputeMinBond.Equal(&that1.DisputeMinBond) {
+		return false
+	}
+	if this.DisputeResponseWindow != that1.DisputeResponseWindow {
+		return false
+	}
+	if this.DisputeResolutionWindow != that1.DisputeResolutionWindow {
+		return false
+	}
+	if this.DisputeJury != that1.DisputeJury {
+		return false
+	}
+	if !this.DisputeSlashFraction.Equal(that1.DisputeSlashFraction) {
+		return false
+	}
+	if this.DisputeReputationPenalty != that1.DisputeReputationPenalty {
+		return false
+	}
+	if len(this.TierBonds) != len(that1.TierBonds) {
+		return false
+	}
+	for i := range this.TierBonds {
+		if !this.TierBonds[i].Equal(&that1.TierBonds[i]) {
+			return false
+		}
+	}
+	if this.UnbondingPeriod != that1.UnbondingPeriod {
+		return false
+	}
+	if !this.BondSlashDispute.Equal(that1.BondSlashDispute) {
+		return false
+	}
+	if !this.BondSlashRevocation.Equal(that1.BondSlashRevocation) {
+		return false
+	}
+	if !this.BondSlashBan.Equal(that1.BondSlashBan) {
+		return false
+	}
+	if !this.SwapFee.Equal(that1.SwapFee) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -192,16 +419,202 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	{
+		size := m.BondSlashBan.Size()
+		i -= size
+		if _, err := m.BondSlashBan.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	{
+		size := m.BondSlashRevocation.Size()
+		i -= size
+		if _, err := m.BondSlashRevocation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size := m.BondSlashDispute.Size()
+		i -= size
+		if _, err := m.BondSlashDispute.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.UnbondingPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnbondingPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.TierBonds) > 0 {
+		for iNdEx := len(m.TierBonds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TierBonds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.DisputeReputationPenalty != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeReputationPenalty))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size := m.DisputeSlashFraction.Size()
+		i -= size
+		if _, err := m.DisputeSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.DisputeJury) > 0 {
+		i -= len(m.DisputeJury)
+		copy(dAtA[i:], m.DisputeJury)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.DisputeJury)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.DisputeResolutionWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeResolutionWindow))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.DisputeResponseWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeResponseWindow))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size, err := m.DisputeMinBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.VestingPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VestingPeriod))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.MaxTotalEmission.Size()
+		i -= size
+		if _, err := m.MaxTotalEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.HalvingInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HalvingInterval))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.EpochEmission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MaxRewardBoost.Size()
+		i -= size
+		if _, err := m.MaxRewardBoost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.EntropyTarget.Size()
+		i -= size
+		if _, err := m.EntropyTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.RegionGeohashPrecision != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RegionGeohashPrecision))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.SecurityWeights) > 0 {
+		keysForSecurityWeights := make([]string, 0, len(m.SecurityWeights))
 		for k := range m.SecurityWeights {
-			v := m.SecurityWeights[k]
+			keysForSecurityWeights = append(keysForSecurityWeights, string(k))
+		}
+		github_com_cosmos_gogoproto_sortkeys.Strings(keysForSecurityWeights)
+		for iNdEx := len(keysForSecurityWeights) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.SecurityWeights[string(keysForSecurityWeights[iNdEx])]
 			baseI := i
 			i = encodeVarintParams(dAtA, i, uint64(v))
 			i--
 			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintParams(dAtA, i, uint64(len(k)))
+			i -= len(keysForSecurityWeights[iNdEx])
+			copy(dAtA[i:], keysForSecurityWeights[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(keysForSecurityWeights[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = encodeVarintParams(dAtA, i, uint64(baseI-i))
@@ -261,6 +674,61 @@ func (m *Params) Size() (n int) {
 			n += mapEntrySize + 1 + sovParams(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.RegionGeohashPrecision != 0 {
+		n += 1 + sovParams(uint64(m.RegionGeohashPrecision))
+	}
+	l = m.EntropyTarget.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxRewardBoost.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.EpochEmission.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.HalvingInterval != 0 {
+		n += 1 + sovParams(uint64(m.HalvingInterval))
+	}
+	l = m.MaxTotalEmission.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.VestingPeriod != 0 {
+		n += 1 + sovParams(uint64(m.VestingPeriod))
+	}
+	l = m.DisputeMinBond.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DisputeResponseWindow != 0 {
+		n += 1 + sovParams(uint64(m.DisputeResponseWindow))
+	}
+	if m.DisputeResolutionWindow != 0 {
+		n += 1 + sovParams(uint64(m.DisputeResolutionWindow))
+	}
+	l = len(m.DisputeJury)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = m.DisputeSlashFraction.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.DisputeReputationPenalty != 0 {
+		n += 2 + sovParams(uint64(m.DisputeReputationPenalty))
+	}
+	if len(m.TierBonds) > 0 {
+		for _, e := range m.TierBonds {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.UnbondingPeriod != 0 {
+		n += 2 + sovParams(uint64(m.UnbondingPeriod))
+	}
+	l = m.BondSlashDispute.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.BondSlashRevocation.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.BondSlashBan.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.SwapFee.Size()
+	n += 2 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -469,6 +937,575 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.SecurityWeights[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionGeohashPrecision", wireType)
+			}
+			m.RegionGeohashPrecision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionGeohashPrecision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntropyTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EntropyTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardBoost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRewardBoost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEmission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+			}
+			m.HalvingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTotalEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriod", wireType)
+			}
+			m.VestingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VestingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeMinBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisputeMinBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeResponseWindow", wireType)
+			}
+			m.DisputeResponseWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeResponseWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeResolutionWindow", wireType)
+			}
+			m.DisputeResolutionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeResolutionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeJury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisputeJury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisputeSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeReputationPenalty", wireType)
+			}
+			m.DisputeReputationPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeReputationPenalty |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TierBonds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TierBonds = append(m.TierBonds, TierBond{})
+			if err := m.TierBonds[len(m.TierBonds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			m.UnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondSlashDispute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondSlashDispute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondSlashRevocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondSlashRevocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondSlashBan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondSlashBan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
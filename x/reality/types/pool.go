@@ -0,0 +1,239 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PoolShareDenomPrefix prefixes the LP share denom of every pool.
+const PoolShareDenomPrefix = "reality/pool/"
+
+// MaxRouteHops limits the number of pools a swap can go through.
+const MaxRouteHops = 4
+
+// MaxRouteSearch bounds the number of partial routes the best route search
+// explores, so that the query stays cheap however many pools exist.
+const MaxRouteSearch = 2000
+
+// PoolShareDenom returns the LP share denom of a pool.
+func PoolShareDenom(poolID uint64) string {
+	return fmt.Sprintf("%s%d", PoolShareDenomPrefix, poolID)
+}
+
+// IsPoolShareDenom reports whether denom is the LP share denom of a pool.
+func IsPoolShareDenom(denom string) bool {
+	return strings.HasPrefix(denom, PoolShareDenomPrefix)
+}
+
+// SortDenoms returns the two denoms in pool order.
+func SortDenoms(a, b string) (string, string) {
+	if a > b {
+		return b, a
+	}
+	return a, b
+}
+
+// NewPool creates a pool from its initial reserves. The initial share supply
+// is the geometric mean of the reserves.
+func NewPool(id uint64, tokenA, tokenB sdk.Coin) (Pool, error) {
+	if tokenA.Denom == tokenB.Denom {
+		return Pool{}, fmt.Errorf("pool denoms must differ: %s", tokenA.Denom)
+	}
+	if !tokenA.IsPositive() || !tokenB.IsPositive() {
+		return Pool{}, fmt.Errorf("initial reserves must be positive: %s, %s", tokenA, tokenB)
+	}
+	if tokenA.Denom > tokenB.Denom {
+		tokenA, tokenB = tokenB, tokenA
+	}
+
+	shares := math.NewIntFromBigInt(new(big.Int).Sqrt(tokenA.Amount.Mul(tokenB.Amount).BigInt()))
+	if !shares.IsPositive() {
+		return Pool{}, fmt.Errorf("initial reserves too small")
+	}
+	return Pool{
+		Id:          id,
+		ReserveA:    tokenA,
+		ReserveB:    tokenB,
+		TotalShares: shares,
+		ShareDenom:  PoolShareDenom(id),
+	}, nil
+}
+
+// Reserves returns both reserves of the pool.
+func (p Pool) Reserves() sdk.Coins {
+	return sdk.NewCoins(p.ReserveA, p.ReserveB)
+}
+
+// HasDenom reports whether denom is one of the pool denoms.
+func (p Pool) HasDenom(denom string) bool {
+	return p.ReserveA.Denom == denom || p.ReserveB.Denom == denom
+}
+
+// reservesFor returns the (in, out) reserves of a swap from denomIn.
+func (p Pool) reservesFor(denomIn string) (sdk.Coin, sdk.Coin, error) {
+	switch denomIn {
+	case p.ReserveA.Denom:
+		return p.ReserveA, p.ReserveB, nil
+	case p.ReserveB.Denom:
+		return p.ReserveB, p.ReserveA, nil
+	}
+	return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(ErrInvalidRoute, "denom %s is not in pool %d", denomIn, p.Id)
+}
+
+// SwapOut returns the output of selling tokenIn to the pool and the fee kept
+// by the pool: dy = y * dx' / (x + dx'), where dx' is tokenIn minus the fee.
+func (p Pool) SwapOut(tokenIn sdk.Coin, fee math.LegacyDec) (tokenOut, feeAmount sdk.Coin, err error) {
+	reserveIn, reserveOut, err := p.reservesFor(tokenIn.Denom)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	if !tokenIn.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("swap amount must be positive: %s", tokenIn)
+	}
+
+	feeAmount = sdk.NewCoin(tokenIn.Denom, fee.MulInt(tokenIn.Amount).Ceil().TruncateInt())
+	dx := tokenIn.Amount.Sub(feeAmount.Amount)
+	dy := reserveOut.Amount.Mul(dx).Quo(reserveIn.Amount.Add(dx))
+	if !dy.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(ErrInsufficientLiquidity, "swap of %s is too small for pool %d", tokenIn, p.Id)
+	}
+	return sdk.NewCoin(reserveOut.Denom, dy), feeAmount, nil
+}
+
+// SpotPrice returns the marginal price of one unit of baseDenom in the other
+// pool denom.
+func (p Pool) SpotPrice(baseDenom string) (math.LegacyDec, error) {
+	reserveBase, reserveQuote, err := p.reservesFor(baseDenom)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if !reserveBase.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(ErrInsufficientLiquidity, "pool %d has no %s reserve", p.Id, baseDenom)
+	}
+	return math.LegacyNewDecFromInt(reserveQuote.Amount).QuoInt(reserveBase.Amount), nil
+}
+
+// PriceImpact returns 1 - execution price / spot price of a swap quoted by
+// SwapOut. The fee is excluded so that only the move along the curve counts.
+func (p Pool) PriceImpact(tokenIn, tokenOut, feeAmount sdk.Coin) (math.LegacyDec, error) {
+	spot, err := p.SpotPrice(tokenIn.Denom)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	dx := tokenIn.Amount.Sub(feeAmount.Amount)
+	if !dx.IsPositive() || !spot.IsPositive() {
+		return math.LegacyOneDec(), nil
+	}
+	execution := math.LegacyNewDecFromInt(tokenOut.Amount).QuoInt(dx)
+	return math.LegacyOneDec().Sub(execution.Quo(spot)), nil
+}
+
+// QuoteRoute quotes selling tokenIn through pools in order, each hop selling
+// the output of the previous one. The pools are not modified.
+func QuoteRoute(pools []Pool, tokenIn sdk.Coin, fee math.LegacyDec) ([]SwapHop, error) {
+	hops := make([]SwapHop, 0, len(pools))
+	for _, pool := range pools {
+		tokenOut, feeAmount, err := pool.SwapOut(tokenIn, fee)
+		if err != nil {
+			return nil, err
+		}
+		hops = append(hops, SwapHop{PoolId: pool.Id, TokenIn: tokenIn, TokenOut: tokenOut, Fee: feeAmount})
+		tokenIn = tokenOut
+	}
+	return hops, nil
+}
+
+// RoutePrice returns the spot price of a route before the swap and the price
+// impact of hops quoted on it. Both compound over the hops.
+func RoutePrice(pools []Pool, hops []SwapHop) (spot, impact math.LegacyDec, err error) {
+	spot, kept := math.LegacyOneDec(), math.LegacyOneDec()
+	for i, pool := range pools {
+		price, err := pool.SpotPrice(hops[i].TokenIn.Denom)
+		if err != nil {
+			return math.LegacyDec{}, math.LegacyDec{}, err
+		}
+		hopImpact, err := pool.PriceImpact(hops[i].TokenIn, hops[i].TokenOut, hops[i].Fee)
+		if err != nil {
+			return math.LegacyDec{}, math.LegacyDec{}, err
+		}
+		spot = spot.Mul(price)
+		kept = kept.Mul(math.LegacyOneDec().Sub(hopImpact))
+	}
+	return spot, math.LegacyOneDec().Sub(kept), nil
+}
+
+// ApplySwap moves tokenIn into and tokenOut out of the reserves.
+func (p *Pool) ApplySwap(tokenIn, tokenOut sdk.Coin) {
+	if tokenIn.Denom == p.ReserveA.Denom {
+		p.ReserveA = p.ReserveA.Add(tokenIn)
+		p.ReserveB = p.ReserveB.Sub(tokenOut)
+		return
+	}
+	p.ReserveB = p.ReserveB.Add(tokenIn)
+	p.ReserveA = p.ReserveA.Sub(tokenOut)
+}
+
+// DepositShares returns the shares minted for depositing at most maxTokens
+// and the amounts actually taken at the pool ratio.
+func (p Pool) DepositShares(maxTokens sdk.Coins) (math.Int, sdk.Coins, error) {
+	maxA, maxB := maxTokens.AmountOf(p.ReserveA.Denom), maxTokens.AmountOf(p.ReserveB.Denom)
+	if p.TotalShares.IsZero() {
+		// 모든 유동성이 회수된 풀은 새 풀처럼 다시 시작
+		empty, err := NewPool(p.Id, sdk.NewCoin(p.ReserveA.Denom, maxA), sdk.NewCoin(p.ReserveB.Denom, maxB))
+		if err != nil {
+			return math.ZeroInt(), nil, err
+		}
+		return empty.TotalShares, empty.Reserves(), nil
+	}
+	shares := math.MinInt(
+		maxA.Mul(p.TotalShares).Quo(p.ReserveA.Amount),
+		maxB.Mul(p.TotalShares).Quo(p.ReserveB.Amount),
+	)
+	if !shares.IsPositive() {
+		return math.ZeroInt(), nil, errorsmod.Wrapf(ErrInsufficientLiquidity, "deposit %s is too small for pool %d", maxTokens, p.Id)
+	}
+
+	// 풀에 유리하도록 올림
+	deposit := sdk.NewCoins(
+		sdk.NewCoin(p.ReserveA.Denom, ceilDiv(shares.Mul(p.ReserveA.Amount), p.TotalShares)),
+		sdk.NewCoin(p.ReserveB.Denom, ceilDiv(shares.Mul(p.ReserveB.Amount), p.TotalShares)),
+	)
+	return shares, deposit, nil
+}
+
+// WithdrawTokens returns the reserves redeemed by shares.
+func (p Pool) WithdrawTokens(shares math.Int) (sdk.Coins, error) {
+	if !shares.IsPositive() || shares.GT(p.TotalShares) {
+		return nil, fmt.Errorf("invalid share amount %s for pool %d with %s shares", shares, p.Id, p.TotalShares)
+	}
+	return sdk.NewCoins(
+		sdk.NewCoin(p.ReserveA.Denom, shares.Mul(p.ReserveA.Amount).Quo(p.TotalShares)),
+		sdk.NewCoin(p.ReserveB.Denom, shares.Mul(p.ReserveB.Amount).Quo(p.TotalShares)),
+	), nil
+}
+
+// Validate checks the pool invariants.
+func (p Pool) Validate() error {
+	if p.ReserveA.Denom >= p.ReserveB.Denom {
+		return fmt.Errorf("pool %d denoms must be sorted and distinct: %s, %s", p.Id, p.ReserveA.Denom, p.ReserveB.Denom)
+	}
+	if err := p.Reserves().Validate(); err != nil {
+		return fmt.Errorf("invalid reserves of pool %d: %w", p.Id, err)
+	}
+	if p.TotalShares.IsNil() || p.TotalShares.IsNegative() {
+		return fmt.Errorf("pool %d shares must be non-negative", p.Id)
+	}
+	if p.ShareDenom != PoolShareDenom(p.Id) {
+		return fmt.Errorf("pool %d has share denom %s", p.Id, p.ShareDenom)
+	}
+	return nil
+}
+
+func ceilDiv(a, b math.Int) math.Int {
+	return a.Add(b).SubRaw(1).Quo(b)
+}
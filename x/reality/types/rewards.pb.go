@@ -0,0 +1,702 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: contactical/reality/v1/rewards.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VestingTranche is the reward a node earned in one epoch, held in the module
+// escrow and vesting linearly from start_time to end_time. A clawback burns
+// part of the unvested amount and restarts the remaining schedule at the
+// clawback time, moving what had vested so far into vested_base.
+type VestingTranche struct {
+	Node        string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	EpochIndex  uint64 `protobuf:"varint,2,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	EpochNumber int64  `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// points are the claim points the tranche was paid for.
+	Points     int64                                    `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	VestedBase github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=vested_base,json=vestedBase,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested_base"`
+	Vesting    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=vesting,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vesting"`
+	Withdrawn  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=withdrawn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn"`
+	StartTime  int64                                    `protobuf:"varint,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime    int64                                    `protobuf:"varint,9,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *VestingTranche) Reset()         { *m = VestingTranche{} }
+func (m *VestingTranche) String() string { return proto.CompactTextString(m) }
+func (*VestingTranche) ProtoMessage()    {}
+func (*VestingTranche) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77febdec56cfe4c7, []int{0}
+}
+func (m *VestingTranche) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingTranche) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingTranche.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingTranche) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingTranche.Merge(m, src)
+}
+func (m *VestingTranche) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingTranche) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingTranche.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingTranche proto.InternalMessageInfo
+
+func (m *VestingTranche) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *VestingTranche) GetEpochIndex() uint64 {
+	if m != nil {
+		return m.EpochIndex
+	}
+	return 0
+}
+
+func (m *VestingTranche) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *VestingTranche) GetPoints() int64 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
+}
+
+func (m *VestingTranche) GetVestedBase() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.VestedBase
+	}
+	return nil
+}
+
+func (m *VestingTranche) GetVesting() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vesting
+	}
+	return nil
+}
+
+func (m *VestingTranche) GetWithdrawn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return nil
+}
+
+func (m *VestingTranche) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *VestingTranche) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*VestingTranche)(nil), "contactical.reality.v1.VestingTranche")
+}
+
+func init() {
+	proto.RegisterFile("contactical/reality/v1/rewards.proto", fileDescriptor_77febdec56cfe4c7)
+}
+
+var fileDescriptor_77febdec56cfe4c7 = []byte{
+	// 412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0x41, 0x6e, 0x13, 0x31,
+	0x14, 0x8d, 0x49, 0x48, 0x1a, 0x07, 0x21, 0x61, 0xa1, 0xca, 0x2d, 0x62, 0x12, 0x10, 0x8b, 0x11,
+	0x12, 0xb6, 0x02, 0xea, 0x05, 0xc2, 0x8a, 0x0d, 0x8b, 0xa8, 0x62, 0xc1, 0x26, 0xf2, 0xd8, 0x5f,
+	0x19, 0x43, 0xc7, 0x1e, 0x6c, 0x77, 0xd2, 0x1e, 0x81, 0x1d, 0xc7, 0x40, 0xac, 0x38, 0x46, 0x97,
+	0x5d, 0xb2, 0x02, 0x94, 0x2c, 0xb8, 0x06, 0x1a, 0x7b, 0xa0, 0xbd, 0x40, 0x36, 0xf6, 0xf7, 0x7b,
+	0xef, 0xeb, 0xfd, 0x67, 0x7d, 0xfc, 0x4c, 0x5a, 0x13, 0x84, 0x0c, 0x5a, 0x8a, 0x33, 0xee, 0x40,
+	0x9c, 0xe9, 0x70, 0xc9, 0x9b, 0x39, 0x77, 0xb0, 0x11, 0x4e, 0x79, 0x56, 0x3b, 0x1b, 0x2c, 0x39,
+	0xbc, 0xa5, 0x62, 0x9d, 0x8a, 0x35, 0xf3, 0xe3, 0x07, 0xa2, 0xd2, 0xc6, 0xf2, 0x78, 0x26, 0xe9,
+	0x71, 0x26, 0xad, 0xaf, 0xac, 0xe7, 0x85, 0xf0, 0xc0, 0x9b, 0x79, 0x01, 0x41, 0xcc, 0xb9, 0xb4,
+	0xda, 0x74, 0xfc, 0xc3, 0xb5, 0x5d, 0xdb, 0x58, 0xf2, 0xb6, 0x4a, 0xe8, 0xd3, 0xcf, 0x03, 0x7c,
+	0xff, 0x1d, 0xf8, 0xa0, 0xcd, 0xfa, 0xd4, 0x09, 0x23, 0x4b, 0x20, 0x04, 0x0f, 0x8c, 0x55, 0x40,
+	0xd1, 0x0c, 0xe5, 0xe3, 0x65, 0xac, 0xc9, 0x14, 0x4f, 0xa0, 0xb6, 0xb2, 0x5c, 0x69, 0xa3, 0xe0,
+	0x82, 0xde, 0x99, 0xa1, 0x7c, 0xb0, 0xc4, 0x11, 0x7a, 0xd3, 0x22, 0xe4, 0x09, 0xbe, 0x97, 0x04,
+	0xe6, 0xbc, 0x2a, 0xc0, 0xd1, 0xfe, 0x0c, 0xe5, 0xfd, 0x65, 0x6a, 0x7a, 0x1b, 0x21, 0x72, 0x88,
+	0x87, 0xb5, 0xd5, 0x26, 0x78, 0x3a, 0x88, 0x64, 0xf7, 0x22, 0x9f, 0xf0, 0xa4, 0x01, 0x1f, 0x40,
+	0xad, 0xda, 0xd1, 0xe9, 0xdd, 0x59, 0x3f, 0x9f, 0xbc, 0x3c, 0x62, 0x29, 0x0e, 0x6b, 0x31, 0xd6,
+	0xc5, 0x61, 0xaf, 0xad, 0x36, 0x8b, 0x93, 0xab, 0x9f, 0xd3, 0xde, 0xb7, 0x5f, 0xd3, 0x7c, 0xad,
+	0x43, 0x79, 0x5e, 0x30, 0x69, 0x2b, 0xde, 0x65, 0x4f, 0xd7, 0x0b, 0xaf, 0x3e, 0xf2, 0x70, 0x59,
+	0x83, 0x8f, 0x0d, 0xfe, 0xeb, 0x9f, 0xef, 0xcf, 0xd1, 0x12, 0x27, 0x93, 0x85, 0xf0, 0x40, 0x3e,
+	0xe0, 0x51, 0x93, 0x42, 0xd3, 0xe1, 0x9e, 0xec, 0xfe, 0x19, 0x10, 0x83, 0xc7, 0x1b, 0x1d, 0x4a,
+	0xe5, 0xc4, 0xc6, 0xd0, 0xd1, 0x9e, 0xdc, 0x6e, 0x2c, 0xc8, 0x63, 0x8c, 0x7d, 0x10, 0x2e, 0xac,
+	0x82, 0xae, 0x80, 0x1e, 0xc4, 0xaf, 0x1e, 0x47, 0xe4, 0x54, 0x57, 0x40, 0x8e, 0xf0, 0x01, 0x18,
+	0x95, 0xc8, 0x71, 0x24, 0x47, 0x60, 0x54, 0x4b, 0x2d, 0x4e, 0xae, 0xb6, 0x19, 0xba, 0xde, 0x66,
+	0xe8, 0xf7, 0x36, 0x43, 0x5f, 0x76, 0x59, 0xef, 0x7a, 0x97, 0xf5, 0x7e, 0xec, 0xb2, 0xde, 0xfb,
+	0x47, 0xb7, 0x97, 0xf5, 0xe2, 0xff, 0xba, 0xc6, 0x31, 0x8a, 0x61, 0xdc, 0xa4, 0x57, 0x7f, 0x03,
+	0x00, 0x00, 0xff, 0xff, 0xbd, 0x34, 0xd3, 0x0b, 0xd2, 0x02, 0x00, 0x00,
+}
+
+func (m *VestingTranche) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingTranche) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingTranche) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Withdrawn) > 0 {
+		for iNdEx := len(m.Withdrawn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Vesting) > 0 {
+		for iNdEx := len(m.Vesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VestedBase) > 0 {
+		for iNdEx := len(m.VestedBase) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestedBase[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Points != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Points))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochIndex != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRewards(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRewards(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VestingTranche) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if m.EpochIndex != 0 {
+		n += 1 + sovRewards(uint64(m.EpochIndex))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovRewards(uint64(m.EpochNumber))
+	}
+	if m.Points != 0 {
+		n += 1 + sovRewards(uint64(m.Points))
+	}
+	if len(m.VestedBase) > 0 {
+		for _, e := range m.VestedBase {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.Vesting) > 0 {
+		for _, e := range m.Vesting {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.Withdrawn) > 0 {
+		for _, e := range m.Withdrawn {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovRewards(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovRewards(uint64(m.EndTime))
+	}
+	return n
+}
+
+func sovRewards(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRewards(x uint64) (n int) {
+	return sovRewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VestingTranche) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingTranche: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingTranche: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			}
+			m.EpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			m.Points = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Points |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestedBase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestedBase = append(m.VestedBase, types.Coin{})
+			if err := m.VestedBase[len(m.VestedBase)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vesting = append(m.Vesting, types.Coin{})
+			if err := m.Vesting[len(m.Vesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawn = append(m.Withdrawn, types.Coin{})
+			if err := m.Withdrawn[len(m.Withdrawn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRewards(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRewards
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRewards
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRewards
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRewards        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRewards          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRewards = fmt.Errorf("proto: unexpected end of group")
+)
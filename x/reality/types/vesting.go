@@ -0,0 +1,78 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewVestingTranche creates a tranche vesting amount linearly over period
+// seconds starting at now.
+func NewVestingTranche(node string, epochIndex uint64, epochNumber, points int64, amount sdk.Coins, now int64, period uint64) VestingTranche {
+	return VestingTranche{
+		Node:        node,
+		EpochIndex:  epochIndex,
+		EpochNumber: epochNumber,
+		Points:      points,
+		VestedBase:  sdk.NewCoins(),
+		Vesting:     amount,
+		Withdrawn:   sdk.NewCoins(),
+		StartTime:   now,
+		EndTime:     now + int64(period),
+	}
+}
+
+// vestedSchedule returns the part of the vesting schedule unlocked at now.
+func (t VestingTranche) vestedSchedule(now int64) sdk.Coins {
+	if now >= t.EndTime {
+		return t.Vesting
+	}
+	if now <= t.StartTime {
+		return sdk.NewCoins()
+	}
+
+	elapsed, duration := now-t.StartTime, t.EndTime-t.StartTime
+	vested := sdk.NewCoins()
+	for _, coin := range t.Vesting {
+		vested = vested.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(elapsed).QuoRaw(duration)))
+	}
+	return vested
+}
+
+// Vested returns the total amount vested at now, including withdrawn rewards.
+func (t VestingTranche) Vested(now int64) sdk.Coins {
+	return t.VestedBase.Add(t.vestedSchedule(now)...)
+}
+
+// Unvested returns the amount still locked at now.
+func (t VestingTranche) Unvested(now int64) sdk.Coins {
+	return t.Vesting.Sub(t.vestedSchedule(now)...)
+}
+
+// Withdrawable returns the vested amount that has not been withdrawn yet.
+func (t VestingTranche) Withdrawable(now int64) sdk.Coins {
+	withdrawable, _ := t.Vested(now).SafeSub(t.Withdrawn...)
+	return withdrawable
+}
+
+// Held returns the amount of the tranche still held in escrow: everything
+// minted for it that was neither withdrawn nor clawed back.
+func (t VestingTranche) Held() sdk.Coins {
+	return t.VestedBase.Add(t.Vesting...).Sub(t.Withdrawn...)
+}
+
+// IsDone reports whether the tranche fully vested and was fully withdrawn.
+func (t VestingTranche) IsDone(now int64) bool {
+	return now >= t.EndTime && t.Withdrawable(now).IsZero()
+}
+
+// Clawback removes amount from the unvested part at now and restarts the
+// remaining schedule from now. The amount must not exceed Unvested(now).
+func (t *VestingTranche) Clawback(now int64, amount sdk.Coins) {
+	t.VestedBase = t.Vested(now)
+	t.Vesting = t.Unvested(now).Sub(amount...)
+	if now > t.StartTime {
+		t.StartTime = now
+	}
+	if t.EndTime < t.StartTime {
+		t.EndTime = t.StartTime
+	}
+}